@@ -6,6 +6,7 @@ import (
 
 var (
 	baseURL               = "https://api.contentful.com"
+	uploadURL             = "https://upload.contentful.com"
 	contentfulContentType = "application/vnd.contentful.management.v1+json"
 	// User friendly errors we return
 	errorUnauthorized         = errors.New("401 Unauthorized. Is the CMA token valid?")
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+// linkContentTypesTimeout is how long we wait for content types referenced by
+// link_content_types to show up in the API after they are created.
+const linkContentTypesTimeout = 2 * time.Minute
+
+// checkLinkContentTypes fails when link_content_types is set on a field, or on
+// its items, that does not link to entries.
+func checkLinkContentTypes(field map[string]interface{}) error {
+	if len(field["link_content_types"].([]interface{})) > 0 &&
+		(field["type"].(string) != "Link" || field["link_type"].(string) != "Entry") {
+		return fmt.Errorf("link_content_types is only supported on Link fields with link_type Entry")
+	}
+
+	for _, rawItems := range field["items"].(*schema.Set).List() {
+		items := rawItems.(map[string]interface{})
+
+		if len(items["link_content_types"].([]interface{})) > 0 &&
+			(items["type"].(string) != "Link" || items["link_type"].(string) != "Entry") {
+			return fmt.Errorf("link_content_types is only supported on items of type Link with link_type Entry")
+		}
+	}
+
+	return nil
+}
+
+// fieldLinkContentTypes returns the content types referenced by the field, its
+// items and the nodes of its rich_text block.
+func fieldLinkContentTypes(field map[string]interface{}) []string {
+	var contentTypes []string
+
+	for _, contentType := range field["link_content_types"].([]interface{}) {
+		contentTypes = append(contentTypes, contentType.(string))
+	}
+
+	for _, rawItems := range field["items"].(*schema.Set).List() {
+		for _, contentType := range rawItems.(map[string]interface{})["link_content_types"].([]interface{}) {
+			contentTypes = append(contentTypes, contentType.(string))
+		}
+	}
+
+	for _, rawRichText := range field["rich_text"].([]interface{}) {
+		if rawRichText == nil {
+			continue
+		}
+
+		for _, rawNode := range rawRichText.(map[string]interface{})["node"].(*schema.Set).List() {
+			for _, contentType := range rawNode.(map[string]interface{})["link_content_types"].([]interface{}) {
+				contentTypes = append(contentTypes, contentType.(string))
+			}
+		}
+	}
+
+	return contentTypes
+}
+
+// linkedContentTypes returns the content types referenced by the fields, other
+// than the content type itself.
+func linkedContentTypes(fields *schema.Set, self string) []string {
+	var contentTypes []string
+
+	for _, rawField := range fields.List() {
+		for _, contentTypeID := range fieldLinkContentTypes(rawField.(map[string]interface{})) {
+			if contentTypeID != self {
+				contentTypes = append(contentTypes, contentTypeID)
+			}
+		}
+	}
+
+	return contentTypes
+}
+
+// expandLinkContentTypes converts link_content_types into the linkContentType
+// validation.
+func expandLinkContentTypes(contentTypes []interface{}) []interface{} {
+	if len(contentTypes) == 0 {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{"linkContentType": contentTypes},
+	}
+}
+
+// flattenLinkContentTypes returns the content types of the linkContentType
+// validation among the validations returned by the API.
+func flattenLinkContentTypes(rawValidations interface{}) []interface{} {
+	validations, _ := rawValidations.([]interface{})
+
+	for _, rawValidation := range validations {
+		validation, ok := rawValidation.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if contentTypes, ok := validation["linkContentType"].([]interface{}); ok {
+			return contentTypes
+		}
+	}
+
+	return []interface{}{}
+}
+
+// waitForContentTypes waits until the referenced content types exist in the
+// environment. IDs known at plan time are already checked by CustomizeDiff,
+// the ones interpolated from content types created in the same apply can take
+// a little while to show up after their creation.
+func waitForContentTypes(client *contentful.Contentful, spaceID, environment string, contentTypeIDs []string) error {
+	if len(contentTypeIDs) == 0 {
+		return nil
+	}
+
+	return resource.Retry(linkContentTypesTimeout, func() *resource.RetryError {
+		err := checkContentTypesExist(client, spaceID, environment, contentTypeIDs)
+		if _, ok := err.(*missingContentTypesError); ok {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+// missingContentTypesError lists the referenced content types that do not
+// exist in the environment.
+type missingContentTypesError struct {
+	SpaceID        string
+	Environment    string
+	ContentTypeIDs []string
+}
+
+func (e *missingContentTypesError) Error() string {
+	return fmt.Sprintf(
+		"Content type(s) %s referenced by link_content_types do not exist in environment %s of space %s",
+		strings.Join(e.ContentTypeIDs, ", "), e.Environment, e.SpaceID,
+	)
+}
+
+// checkContentTypesExist fails with the referenced content types that do not
+// exist in the environment.
+func checkContentTypesExist(client *contentful.Contentful, spaceID, environment string, contentTypeIDs []string) error {
+	if len(contentTypeIDs) == 0 {
+		return nil
+	}
+
+	existing := map[string]bool{}
+
+	err := cmaList(client, environmentPath(spaceID, environment)+"/content_types", nil, 0, func(item json.RawMessage) error {
+		var contentType struct {
+			Sys entitySys `json:"sys"`
+		}
+
+		if err := json.Unmarshal(item, &contentType); err != nil {
+			return err
+		}
+
+		existing[contentType.Sys.ID] = true
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	missing := map[string]bool{}
+	for _, id := range contentTypeIDs {
+		if !existing[id] {
+			missing[id] = true
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	var ids []string
+	for id := range missing {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return &missingContentTypesError{SpaceID: spaceID, Environment: environment, ContentTypeIDs: ids}
+}
@@ -0,0 +1,282 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+// maxReportedMigrationFailures caps how many failed entries are listed when a
+// migration fails.
+const maxReportedMigrationFailures = 20
+
+func migrationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				// Copies the values of a field into another field.
+				"copy_field": &schema.Schema{
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"from": &schema.Schema{
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"to": &schema.Schema{
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							// Values already in the target field are kept
+							// unless set.
+							"overwrite": &schema.Schema{
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+				// Splits a Symbol or Text field into a list of symbols, e.g.
+				// to fill an Array field.
+				"split_string": &schema.Schema{
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"from": &schema.Schema{
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"to": &schema.Schema{
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"separator": &schema.Schema{
+								Type:     schema.TypeString,
+								Optional: true,
+								Default:  ",",
+							},
+						},
+					},
+				},
+				// Replaces the values of a field found in mapping.
+				"map_value": &schema.Schema{
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"field": &schema.Schema{
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"mapping": &schema.Schema{
+								Type:     schema.TypeMap,
+								Required: true,
+							},
+						},
+					},
+				},
+				// Sets a field in a locale where it has no value.
+				"set_default": &schema.Schema{
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"field": &schema.Schema{
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"locale": &schema.Schema{
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							// The JSON encoded value, e.g. "\"draft\"" or "10".
+							"value": &schema.Schema{
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateFunc:     validation.ValidateJsonString,
+								DiffSuppressFunc: structure.SuppressJsonDiff,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// migrateEntries applies the migration to all entries of the content type.
+// Failing entries do not stop the migration, they are reported together once
+// all entries were processed.
+func migrateEntries(client *contentful.Contentful, spaceID, contentTypeID string, rawMigration []interface{}) error {
+	if len(rawMigration) == 0 || rawMigration[0] == nil {
+		return nil
+	}
+
+	migration := rawMigration[0].(map[string]interface{})
+
+	query := url.Values{}
+	query.Set("content_type", contentTypeID)
+	query.Set("order", "sys.createdAt")
+
+	// Collect all entries up front, updating while paging could skip entries.
+	entries, err := listEntries(client, spaceID, "", query, 0)
+	if err != nil {
+		return err
+	}
+
+	var failures []string
+	failed := 0
+
+	for _, e := range entries {
+		if err = migrateEntry(client, spaceID, contentTypeID, e, migration); err == nil {
+			continue
+		}
+
+		failed++
+
+		if len(failures) < maxReportedMigrationFailures {
+			failures = append(failures, fmt.Sprintf("entry %s: %s", e.Sys.ID, err))
+		}
+	}
+
+	if failed == 0 {
+		return nil
+	}
+
+	if failed > len(failures) {
+		failures = append(failures, fmt.Sprintf("and %d more", failed-len(failures)))
+	}
+
+	return fmt.Errorf(
+		"Migrating %d of %d entries of content type %s failed:\n  %s",
+		failed, len(entries), contentTypeID, strings.Join(failures, "\n  "),
+	)
+}
+
+// migrateEntry applies the migration to the entry and saves it when it
+// changed. Entries that were published without pending changes are published
+// again, so the migration shows up in the delivery API.
+func migrateEntry(client *contentful.Contentful, spaceID, contentTypeID string, e *entry, migration map[string]interface{}) error {
+	changed, err := applyMigration(e, migration)
+	if err != nil || !changed {
+		return err
+	}
+
+	republish := e.Sys.isPublished() && !e.Sys.hasUnpublishedChanges()
+
+	if err = saveEntry(client, spaceID, "", contentTypeID, e); err != nil {
+		return err
+	}
+
+	if republish {
+		return publishEntry(client, spaceID, "", e)
+	}
+
+	return nil
+}
+
+// applyMigration transforms the fields of the entry in place, in the order
+// copy_field, split_string, map_value and set_default. It reports whether the
+// entry changed.
+func applyMigration(e *entry, migration map[string]interface{}) (bool, error) {
+	if e.Fields == nil {
+		e.Fields = map[string]map[string]interface{}{}
+	}
+
+	before, err := json.Marshal(e.Fields)
+	if err != nil {
+		return false, err
+	}
+
+	for _, rawCopy := range migration["copy_field"].([]interface{}) {
+		copyField := rawCopy.(map[string]interface{})
+		from := copyField["from"].(string)
+		to := copyField["to"].(string)
+
+		for locale, value := range e.Fields[from] {
+			if _, ok := e.Fields[to][locale]; ok && !copyField["overwrite"].(bool) {
+				continue
+			}
+
+			setEntryFieldValue(e, to, locale, value)
+		}
+	}
+
+	for _, rawSplit := range migration["split_string"].([]interface{}) {
+		split := rawSplit.(map[string]interface{})
+		from := split["from"].(string)
+
+		for locale, value := range e.Fields[from] {
+			s, ok := value.(string)
+			if !ok {
+				return false, fmt.Errorf("split_string: %s in locale %s is not a string", from, locale)
+			}
+
+			parts := []interface{}{}
+			for _, part := range strings.Split(s, split["separator"].(string)) {
+				if part = strings.TrimSpace(part); part != "" {
+					parts = append(parts, part)
+				}
+			}
+
+			setEntryFieldValue(e, split["to"].(string), locale, parts)
+		}
+	}
+
+	for _, rawMap := range migration["map_value"].([]interface{}) {
+		mapValue := rawMap.(map[string]interface{})
+		mapping := mapValue["mapping"].(map[string]interface{})
+
+		for locale, value := range e.Fields[mapValue["field"].(string)] {
+			if s, ok := value.(string); ok {
+				if mapped, ok := mapping[s]; ok {
+					e.Fields[mapValue["field"].(string)][locale] = mapped
+				}
+			}
+		}
+	}
+
+	for _, rawDefault := range migration["set_default"].([]interface{}) {
+		setDefault := rawDefault.(map[string]interface{})
+		field := setDefault["field"].(string)
+		locale := setDefault["locale"].(string)
+
+		if value, ok := e.Fields[field][locale]; ok && value != nil {
+			continue
+		}
+
+		var value interface{}
+		if err := json.Unmarshal([]byte(setDefault["value"].(string)), &value); err != nil {
+			return false, fmt.Errorf("set_default: invalid value of %s: %s", field, err)
+		}
+
+		setEntryFieldValue(e, field, locale, value)
+	}
+
+	after, err := json.Marshal(e.Fields)
+	if err != nil {
+		return false, err
+	}
+
+	return string(before) != string(after), nil
+}
+
+func setEntryFieldValue(e *entry, field, locale string, value interface{}) {
+	if e.Fields[field] == nil {
+		e.Fields[field] = map[string]interface{}{}
+	}
+
+	e.Fields[field][locale] = value
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// richTextNodeTypes are the node types a RichText field can enable.
+var richTextNodeTypes = []string{
+	"heading-1", "heading-2", "heading-3", "heading-4", "heading-5", "heading-6",
+	"ordered-list", "unordered-list", "hr", "blockquote", "table",
+	"embedded-entry-block", "embedded-entry-inline", "embedded-asset-block",
+	"hyperlink", "entry-hyperlink", "asset-hyperlink",
+}
+
+// richTextMarks are the marks a RichText field can enable.
+var richTextMarks = []string{"bold", "italic", "underline", "code", "superscript", "subscript", "strikethrough"}
+
+// richTextEntryNodeTypes are the node types that link to entries and can be
+// restricted to content types.
+var richTextEntryNodeTypes = []string{"embedded-entry-block", "embedded-entry-inline", "entry-hyperlink"}
+
+// richTextConfigurableNodeTypes are the node types that take a node block.
+var richTextConfigurableNodeTypes = append([]string{"embedded-asset-block", "asset-hyperlink"}, richTextEntryNodeTypes...)
+
+func richTextSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				// All node types are enabled when empty.
+				"enabled_node_types": &schema.Schema{
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(richTextNodeTypes, false),
+					},
+				},
+				// All marks are enabled when empty.
+				"enabled_marks": &schema.Schema{
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(richTextMarks, false),
+					},
+				},
+				"node": &schema.Schema{
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": &schema.Schema{
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(richTextConfigurableNodeTypes, false),
+							},
+							// Only for nodes linking to entries.
+							"link_content_types": &schema.Schema{
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							// Limits how often the node can be used in the field.
+							"min": &schema.Schema{
+								Type:     schema.TypeInt,
+								Optional: true,
+							},
+							"max": &schema.Schema{
+								Type:     schema.TypeInt,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkRichText validates the rich_text block of a field beyond what the
+// schema can express.
+func checkRichText(fieldType string, rawRichText []interface{}) error {
+	if len(rawRichText) == 0 || rawRichText[0] == nil {
+		return nil
+	}
+
+	if fieldType != "RichText" {
+		return fmt.Errorf("rich_text is only supported on RichText fields, not %s", fieldType)
+	}
+
+	richText := rawRichText[0].(map[string]interface{})
+
+	var enabled []string
+	for _, nodeType := range richText["enabled_node_types"].([]interface{}) {
+		enabled = append(enabled, nodeType.(string))
+	}
+
+	for _, rawNode := range richText["node"].(*schema.Set).List() {
+		node := rawNode.(map[string]interface{})
+		nodeType := node["type"].(string)
+
+		if len(enabled) > 0 && !containsString(enabled, nodeType) {
+			return fmt.Errorf("node %s is configured but not in enabled_node_types", nodeType)
+		}
+
+		if len(node["link_content_types"].([]interface{})) > 0 && !containsString(richTextEntryNodeTypes, nodeType) {
+			return fmt.Errorf("link_content_types is only supported on nodes linking to entries, not %s", nodeType)
+		}
+
+		if min, max := node["min"].(int), node["max"].(int); max > 0 && min > max {
+			return fmt.Errorf("min of node %s is greater than its max", nodeType)
+		}
+	}
+
+	return nil
+}
+
+// expandRichTextValidations converts the rich_text block of a field into the
+// enabledNodeTypes, enabledMarks and nodes validations.
+func expandRichTextValidations(rawRichText []interface{}) []interface{} {
+	validations := []interface{}{}
+
+	if len(rawRichText) == 0 || rawRichText[0] == nil {
+		return validations
+	}
+
+	richText := rawRichText[0].(map[string]interface{})
+
+	if nodeTypes := richText["enabled_node_types"].([]interface{}); len(nodeTypes) > 0 {
+		validations = append(validations, map[string]interface{}{"enabledNodeTypes": nodeTypes})
+	}
+
+	if marks := richText["enabled_marks"].([]interface{}); len(marks) > 0 {
+		validations = append(validations, map[string]interface{}{"enabledMarks": marks})
+	}
+
+	nodes := map[string]interface{}{}
+	for _, rawNode := range richText["node"].(*schema.Set).List() {
+		node := rawNode.(map[string]interface{})
+		nodeValidations := []interface{}{}
+
+		if contentTypes := node["link_content_types"].([]interface{}); len(contentTypes) > 0 {
+			nodeValidations = append(nodeValidations, map[string]interface{}{"linkContentType": contentTypes})
+		}
+
+		size := map[string]interface{}{}
+		if min := node["min"].(int); min > 0 {
+			size["min"] = min
+		}
+		if max := node["max"].(int); max > 0 {
+			size["max"] = max
+		}
+		if len(size) > 0 {
+			nodeValidations = append(nodeValidations, map[string]interface{}{"size": size})
+		}
+
+		nodes[node["type"].(string)] = nodeValidations
+	}
+
+	if len(nodes) > 0 {
+		validations = append(validations, map[string]interface{}{"nodes": nodes})
+	}
+
+	return validations
+}
+
+// flattenRichText converts the validations of a field returned by the API
+// back into a rich_text block.
+func flattenRichText(rawValidations interface{}) []interface{} {
+	validations, _ := rawValidations.([]interface{})
+
+	richText := map[string]interface{}{
+		"enabled_node_types": []interface{}{},
+		"enabled_marks":      []interface{}{},
+		"node":               []interface{}{},
+	}
+
+	for _, rawValidation := range validations {
+		validation, ok := rawValidation.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if nodeTypes, ok := validation["enabledNodeTypes"].([]interface{}); ok {
+			richText["enabled_node_types"] = nodeTypes
+		}
+
+		if marks, ok := validation["enabledMarks"].([]interface{}); ok {
+			richText["enabled_marks"] = marks
+		}
+
+		nodes, ok := validation["nodes"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var nodeTypes []string
+		for nodeType := range nodes {
+			nodeTypes = append(nodeTypes, nodeType)
+		}
+		sort.Strings(nodeTypes)
+
+		var flattened []interface{}
+		for _, nodeType := range nodeTypes {
+			node := map[string]interface{}{
+				"type":               nodeType,
+				"link_content_types": []interface{}{},
+				"min":                0,
+				"max":                0,
+			}
+
+			nodeValidations, _ := nodes[nodeType].([]interface{})
+			for _, rawNodeValidation := range nodeValidations {
+				nodeValidation, ok := rawNodeValidation.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				if contentTypes, ok := nodeValidation["linkContentType"].([]interface{}); ok {
+					node["link_content_types"] = contentTypes
+				}
+
+				if size, ok := nodeValidation["size"].(map[string]interface{}); ok {
+					if min, ok := size["min"].(float64); ok {
+						node["min"] = int(min)
+					}
+					if max, ok := size["max"].(float64); ok {
+						node["max"] = int(max)
+					}
+				}
+			}
+
+			flattened = append(flattened, node)
+		}
+
+		richText["node"] = flattened
+	}
+
+	return []interface{}{richText}
+}
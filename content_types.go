@@ -0,0 +1,312 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+// contentTypeFieldExtras holds properties of content type fields that the SDK
+// does not know about, keyed by field ID and API property name.
+type contentTypeFieldExtras map[string]map[string]interface{}
+
+// contentTypeDocument is a content type as returned by the Content Management
+// API, with its fields kept as raw maps so properties unknown to the SDK are
+// available.
+type contentTypeDocument struct {
+	Sys          *entitySys               `json:"sys"`
+	Name         string                   `json:"name"`
+	Description  string                   `json:"description"`
+	DisplayField string                   `json:"displayField"`
+	Fields       []map[string]interface{} `json:"fields"`
+}
+
+func contentTypesPath(spaceID string) string {
+	return fmt.Sprintf("/spaces/%s/content_types", spaceID)
+}
+
+func contentTypePath(spaceID, contentTypeID string) string {
+	return fmt.Sprintf("%s/%s", contentTypesPath(spaceID), contentTypeID)
+}
+
+func getContentTypeDocument(client *contentful.Contentful, spaceID, contentTypeID string) (*contentTypeDocument, error) {
+	doc := &contentTypeDocument{}
+
+	if err := cmaGet(client, contentTypePath(spaceID, contentTypeID), nil, doc); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// field returns the raw field with the given ID, or nil. Renamed fields are
+// found by their API name.
+func (doc *contentTypeDocument) field(id string) map[string]interface{} {
+	for _, field := range doc.Fields {
+		if field["apiName"] == id {
+			return field
+		}
+	}
+
+	for _, field := range doc.Fields {
+		if field["id"] == id && (field["apiName"] == nil || field["apiName"] == id) {
+			return field
+		}
+	}
+
+	return nil
+}
+
+// saveContentType creates or updates the content type like
+// client.ContentTypes.Upsert does, with the extras merged into its fields.
+// The sys of the content type is updated from the response, so it can be
+// activated afterwards.
+func saveContentType(client *contentful.Contentful, spaceID string, ct *contentful.ContentType, extras contentTypeFieldExtras) error {
+	encoded, err := json.Marshal(ct)
+	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{}
+	if err = json.Unmarshal(encoded, &body); err != nil {
+		return err
+	}
+
+	delete(body, "sys")
+
+	if fields, ok := body["fields"].([]interface{}); ok {
+		for _, rawField := range fields {
+			field := rawField.(map[string]interface{})
+
+			for property, value := range extras[field["id"].(string)] {
+				mergeFieldProperty(field, property, value)
+			}
+		}
+	}
+
+	return saveContentTypeBody(client, spaceID, ct, body)
+}
+
+// mergeFieldProperty sets the property of the field, where properties of
+// nested objects are separated by dots, e.g. items.validations. Lists such as
+// validations are appended to.
+func mergeFieldProperty(field map[string]interface{}, property string, value interface{}) {
+	if parts := strings.SplitN(property, ".", 2); len(parts) == 2 {
+		nested, ok := field[parts[0]].(map[string]interface{})
+		if !ok {
+			nested = map[string]interface{}{}
+			field[parts[0]] = nested
+		}
+
+		mergeFieldProperty(nested, parts[1], value)
+		return
+	}
+
+	existing, isList := field[property].([]interface{})
+	if additional, ok := value.([]interface{}); ok && isList {
+		field[property] = append(existing, additional...)
+		return
+	}
+
+	field[property] = value
+}
+
+// saveContentTypeDefinition creates or updates the content type with the raw
+// fields of a definition_json document.
+func saveContentTypeDefinition(client *contentful.Contentful, spaceID string, ct *contentful.ContentType, fields []interface{}) error {
+	body := map[string]interface{}{
+		"name":         ct.Name,
+		"description":  ct.Description,
+		"displayField": ct.DisplayField,
+		"fields":       fields,
+	}
+
+	return saveContentTypeBody(client, spaceID, ct, body)
+}
+
+func saveContentTypeBody(client *contentful.Contentful, spaceID string, ct *contentful.ContentType, body map[string]interface{}) (err error) {
+	var response struct {
+		Sys *contentful.Sys `json:"sys"`
+	}
+
+	if ct.Sys == nil || ct.Sys.ID == "" {
+		err = cmaPost(client, contentTypesPath(spaceID), body, &response)
+	} else {
+		err = cmaPut(client, contentTypePath(spaceID, ct.Sys.ID), ct.Sys.Version, body, &response)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	ct.Sys = response.Sys
+
+	return nil
+}
+
+// contentTypeDefinitionFields returns the fields of a content type JSON
+// document.
+func contentTypeDefinitionFields(definition string) ([]interface{}, error) {
+	var doc struct {
+		Fields []interface{} `json:"fields"`
+	}
+
+	if err := json.Unmarshal([]byte(definition), &doc); err != nil {
+		return nil, err
+	}
+
+	if len(doc.Fields) == 0 {
+		return nil, fmt.Errorf("the document has no fields")
+	}
+
+	for i, rawField := range doc.Fields {
+		field, ok := rawField.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("field %d is not an object", i)
+		}
+
+		for _, property := range []string{"id", "name", "type"} {
+			if value, _ := field[property].(string); value == "" {
+				return nil, fmt.Errorf("field %d has no %s", i, property)
+			}
+		}
+	}
+
+	return doc.Fields, nil
+}
+
+// normalizeContentTypeDefinition returns the fields of a content type JSON
+// document in a canonical form. Properties with their default value are left
+// out, so a document compares equal to the content type returned by the API.
+func normalizeContentTypeDefinition(definition string) (string, error) {
+	fields, err := contentTypeDefinitionFields(definition)
+	if err != nil {
+		return "", err
+	}
+
+	for _, rawField := range fields {
+		field := rawField.(map[string]interface{})
+
+		for _, property := range []string{"required", "localized", "disabled", "omitted"} {
+			if value, ok := field[property].(bool); ok && !value {
+				delete(field, property)
+			}
+		}
+
+		if validations, ok := field["validations"].([]interface{}); ok && len(validations) == 0 {
+			delete(field, "validations")
+		}
+
+		if field["apiName"] == field["id"] {
+			delete(field, "apiName")
+		}
+
+		if items, ok := field["items"].(map[string]interface{}); ok {
+			if validations, ok := items["validations"].([]interface{}); ok && len(validations) == 0 {
+				delete(items, "validations")
+			}
+		}
+	}
+
+	normalized, err := json.Marshal(map[string]interface{}{"fields": fields})
+
+	return string(normalized), err
+}
+
+// contentTypeDocumentDefinition returns the normalized definition of the
+// content type returned by the API.
+func contentTypeDocumentDefinition(doc *contentTypeDocument) (string, error) {
+	encoded, err := json.Marshal(map[string]interface{}{"fields": doc.Fields})
+	if err != nil {
+		return "", err
+	}
+
+	return normalizeContentTypeDefinition(string(encoded))
+}
+
+// suppressContentTypeDefinitionDiff compares content type definitions by
+// their normalized form.
+func suppressContentTypeDefinitionDiff(k, old, new string, d *schema.ResourceData) bool {
+	normalizedOld, err := normalizeContentTypeDefinition(old)
+	if err != nil {
+		return false
+	}
+
+	normalizedNew, err := normalizeContentTypeDefinition(new)
+	if err != nil {
+		return false
+	}
+
+	return normalizedOld == normalizedNew
+}
+
+// flattenContentTypeField converts a field returned by the API into the
+// attributes of a field block. Renamed fields use their API name as ID.
+func flattenContentTypeField(raw map[string]interface{}) (map[string]interface{}, error) {
+	field := map[string]interface{}{
+		"id":            raw["id"],
+		"name":          raw["name"],
+		"type":          raw["type"],
+		"link_type":     "",
+		"required":      raw["required"] == true,
+		"localized":     raw["localized"] == true,
+		"disabled":      raw["disabled"] == true,
+		"omitted":       raw["omitted"] == true,
+		"default_value": flattenFieldDefaultValue(raw["defaultValue"]),
+		"items":         []interface{}{},
+	}
+
+	if apiName, ok := raw["apiName"].(string); ok && apiName != "" {
+		field["id"] = apiName
+	}
+
+	if linkType, ok := raw["linkType"].(string); ok {
+		field["link_type"] = linkType
+	}
+
+	validations, err := flattenValidations(raw["validations"])
+	if err != nil {
+		return nil, err
+	}
+	field["validations"] = validations
+
+	if rawItems, ok := raw["items"].(map[string]interface{}); ok {
+		itemsValidations, err := flattenValidations(rawItems["validations"])
+		if err != nil {
+			return nil, err
+		}
+
+		linkType, _ := rawItems["linkType"].(string)
+
+		field["items"] = []interface{}{
+			map[string]interface{}{
+				"type":        rawItems["type"],
+				"link_type":   linkType,
+				"validations": itemsValidations,
+			},
+		}
+	}
+
+	return field, nil
+}
+
+// flattenValidations encodes each validation as JSON, the format of the
+// validations of field blocks.
+func flattenValidations(raw interface{}) ([]interface{}, error) {
+	validations, _ := raw.([]interface{})
+
+	flattened := []interface{}{}
+	for _, validation := range validations {
+		encoded, err := json.Marshal(validation)
+		if err != nil {
+			return nil, err
+		}
+
+		flattened = append(flattened, string(encoded))
+	}
+
+	return flattened, nil
+}
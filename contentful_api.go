@@ -0,0 +1,400 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+// The helpers in this file talk to the Content Management API directly for
+// the endpoints contentful-go does not cover. They reuse the headers of the
+// configured client, so authentication and organization stay in one place.
+
+const (
+	// collectionPageSize is the page size used when paging through collections.
+	collectionPageSize = 100
+	// maxRateLimitRetries is how often a request is retried after a 429.
+	maxRateLimitRetries = 5
+)
+
+// link is a reference to another entity, e.g. {"sys": {"type": "Link", ...}}.
+type link struct {
+	Sys linkSys `json:"sys"`
+}
+
+type linkSys struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	LinkType string `json:"linkType"`
+}
+
+func newLink(linkType, id string) *link {
+	return &link{Sys: linkSys{ID: id, Type: "Link", LinkType: linkType}}
+}
+
+func newLinks(linkType string, ids []interface{}) []*link {
+	links := []*link{}
+
+	for _, id := range ids {
+		links = append(links, newLink(linkType, id.(string)))
+	}
+
+	return links
+}
+
+func linkIDs(links []*link) []string {
+	ids := []string{}
+
+	for _, l := range links {
+		ids = append(ids, l.Sys.ID)
+	}
+
+	return ids
+}
+
+// entitySys holds the sys properties shared by the entities handled here.
+type entitySys struct {
+	ID               string `json:"id,omitempty"`
+	Type             string `json:"type,omitempty"`
+	Version          int    `json:"version,omitempty"`
+	PublishedVersion int    `json:"publishedVersion,omitempty"`
+	ArchivedVersion  int    `json:"archivedVersion,omitempty"`
+	ContentType      *link  `json:"contentType,omitempty"`
+	User             *link  `json:"user,omitempty"`
+}
+
+func (s *entitySys) isPublished() bool {
+	return s.PublishedVersion > 0 && s.ArchivedVersion == 0
+}
+
+// hasUnpublishedChanges reports whether the entity was changed after it was
+// last published. Publishing increments the version once.
+func (s *entitySys) hasUnpublishedChanges() bool {
+	return s.Version > s.PublishedVersion+1
+}
+
+// entityMetadata holds the metadata of entries and assets.
+type entityMetadata struct {
+	Tags []*link `json:"tags"`
+}
+
+func newEntityMetadata(tags *schema.Set) *entityMetadata {
+	return &entityMetadata{Tags: newLinks("Tag", tags.List())}
+}
+
+// metadataTagIDs returns the IDs of the tags in possibly empty metadata.
+func metadataTagIDs(metadata *entityMetadata) []string {
+	if metadata == nil {
+		return []string{}
+	}
+
+	return linkIDs(metadata.Tags)
+}
+
+// collection is the envelope of every collection response.
+type collection struct {
+	Total int               `json:"total"`
+	Skip  int               `json:"skip"`
+	Limit int               `json:"limit"`
+	Items []json.RawMessage `json:"items"`
+}
+
+// cmaError is returned for every non 2xx response.
+type cmaError struct {
+	StatusCode int
+	ID         string
+	Message    string
+	RequestID  string
+	Details    json.RawMessage
+}
+
+func (e cmaError) Error() string {
+	msg := fmt.Sprintf("%d %s: %s", e.StatusCode, e.ID, e.Message)
+
+	if len(e.Details) > 0 {
+		msg = fmt.Sprintf("%s %s", msg, e.Details)
+	}
+
+	if e.RequestID != "" {
+		msg = fmt.Sprintf("%s (request ID %s)", msg, e.RequestID)
+	}
+
+	return msg
+}
+
+// isNotFound reports whether err is a 404 from either contentful-go or the
+// helpers in this file.
+func isNotFound(err error) bool {
+	switch e := err.(type) {
+	case cmaError:
+		return e.StatusCode == http.StatusNotFound
+	case contentful.NotFoundError, *contentful.NotFoundError:
+		return true
+	}
+
+	return false
+}
+
+// environmentPath returns the path prefix of a space or, when an environment
+// is given, of an environment of that space.
+func environmentPath(spaceID, environment string) string {
+	if environment == "" {
+		return fmt.Sprintf("/spaces/%s", spaceID)
+	}
+
+	return fmt.Sprintf("/spaces/%s/environments/%s", spaceID, environment)
+}
+
+// organizationID returns the organization configured on the provider.
+func organizationID(client *contentful.Contentful) string {
+	return client.Headers["X-Contentful-Organization"]
+}
+
+func organizationPath(client *contentful.Contentful) string {
+	return fmt.Sprintf("/organizations/%s", organizationID(client))
+}
+
+func newCMARequest(client *contentful.Contentful, method, path string, query url.Values, body interface{}) (*http.Request, error) {
+	u, err := url.Parse(baseURL + path)
+	if err != nil {
+		return nil, err
+	}
+
+	if query != nil {
+		u.RawQuery = query.Encode()
+	}
+
+	var payload []byte
+	if body != nil {
+		payload, err = json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequest(method, u.String(), bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range client.Headers {
+		req.Header.Set(key, value)
+	}
+
+	req.Header.Set("Content-Type", contentfulContentType)
+
+	return req, nil
+}
+
+// setVersion sets the version header required by updates and deletes.
+func setVersion(req *http.Request, version int) {
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(version))
+}
+
+// doCMARequest sends the request, retrying when the rate limit is hit, and
+// decodes the response into out when it is not nil.
+func doCMARequest(client *contentful.Contentful, req *http.Request, out interface{}) error {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return err
+			}
+			req.Body = body
+		}
+
+		if client.Debug {
+			log.Printf("[DEBUG] Contentful request: %s %s", req.Method, req.URL)
+		}
+
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+
+		data, err := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		if res.StatusCode == http.StatusTooManyRequests && attempt < maxRateLimitRetries {
+			wait, err := strconv.Atoi(res.Header.Get("X-Contentful-RateLimit-Reset"))
+			if err != nil || wait < 1 {
+				wait = 1
+			}
+
+			log.Printf("[DEBUG] Contentful rate limit exceeded, retrying in %ds", wait)
+			time.Sleep(time.Duration(wait) * time.Second)
+			continue
+		}
+
+		if res.StatusCode < 200 || res.StatusCode > 299 {
+			return parseCMAError(res, data)
+		}
+
+		if out == nil || len(data) == 0 {
+			return nil
+		}
+
+		return json.Unmarshal(data, out)
+	}
+}
+
+func parseCMAError(res *http.Response, data []byte) error {
+	var body struct {
+		Sys       linkSys         `json:"sys"`
+		Message   string          `json:"message"`
+		RequestID string          `json:"requestId"`
+		Details   json.RawMessage `json:"details"`
+	}
+
+	if res.StatusCode == http.StatusUnauthorized {
+		return errorUnauthorized
+	}
+
+	e := cmaError{StatusCode: res.StatusCode, Message: res.Status}
+
+	if err := json.Unmarshal(data, &body); err == nil {
+		e.ID = body.Sys.ID
+		e.Message = body.Message
+		e.RequestID = body.RequestID
+		e.Details = body.Details
+	}
+
+	return e
+}
+
+// cmaGet fetches a single resource into out.
+func cmaGet(client *contentful.Contentful, path string, query url.Values, out interface{}) error {
+	req, err := newCMARequest(client, http.MethodGet, path, query, nil)
+	if err != nil {
+		return err
+	}
+
+	return doCMARequest(client, req, out)
+}
+
+// cmaPost creates a resource and decodes the response into out.
+func cmaPost(client *contentful.Contentful, path string, body, out interface{}) error {
+	req, err := newCMARequest(client, http.MethodPost, path, nil, body)
+	if err != nil {
+		return err
+	}
+
+	return doCMARequest(client, req, out)
+}
+
+// cmaPut creates or updates a resource. A version of 0 omits the header,
+// which creates the resource when it does not exist yet.
+func cmaPut(client *contentful.Contentful, path string, version int, body, out interface{}) error {
+	req, err := newCMARequest(client, http.MethodPut, path, nil, body)
+	if err != nil {
+		return err
+	}
+
+	if version > 0 {
+		setVersion(req, version)
+	}
+
+	return doCMARequest(client, req, out)
+}
+
+// cmaDelete deletes a versioned resource. A version of 0 omits the header.
+func cmaDelete(client *contentful.Contentful, path string, version int, out interface{}) error {
+	req, err := newCMARequest(client, http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if version > 0 {
+		setVersion(req, version)
+	}
+
+	return doCMARequest(client, req, out)
+}
+
+// cmaUpload uploads data through the Upload API and returns the upload ID,
+// which assets reference to import the file.
+func cmaUpload(client *contentful.Contentful, spaceID string, data []byte) (string, error) {
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/spaces/%s/uploads", uploadURL, spaceID), bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+
+	for key, value := range client.Headers {
+		req.Header.Set(key, value)
+	}
+
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	var upload struct {
+		Sys entitySys `json:"sys"`
+	}
+
+	if err = doCMARequest(client, req, &upload); err != nil {
+		return "", err
+	}
+
+	return upload.Sys.ID, nil
+}
+
+// cmaList pages through a collection endpoint and calls fn for every item.
+// When max is positive, listing stops after max items.
+func cmaList(client *contentful.Contentful, path string, query url.Values, max int, fn func(json.RawMessage) error) error {
+	q := url.Values{}
+	for key, values := range query {
+		q[key] = values
+	}
+
+	seen := 0
+	for skip := 0; ; skip += collectionPageSize {
+		q.Set("skip", strconv.Itoa(skip))
+		q.Set("limit", strconv.Itoa(collectionPageSize))
+
+		var page collection
+		if err := cmaGet(client, path, q, &page); err != nil {
+			return err
+		}
+
+		for _, item := range page.Items {
+			if max > 0 && seen >= max {
+				return nil
+			}
+
+			if err := fn(item); err != nil {
+				return err
+			}
+			seen++
+		}
+
+		if len(page.Items) == 0 || skip+len(page.Items) >= page.Total {
+			return nil
+		}
+	}
+}
+
+// cmaCount returns the total number of items matching the query.
+func cmaCount(client *contentful.Contentful, path string, query url.Values) (int, error) {
+	q := url.Values{}
+	for key, values := range query {
+		q[key] = values
+	}
+	q.Set("limit", "1")
+
+	var page collection
+	if err := cmaGet(client, path, q, &page); err != nil {
+		return 0, err
+	}
+
+	return page.Total, nil
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+func testCMAServer(t *testing.T, handler http.HandlerFunc) (*contentful.Contentful, func()) {
+	server := httptest.NewServer(handler)
+
+	previousURL := baseURL
+	baseURL = server.URL
+
+	return contentful.NewCMA("token"), func() {
+		baseURL = previousURL
+		server.Close()
+	}
+}
+
+func TestCMAList_Pagination(t *testing.T) {
+	client, teardown := testCMAServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			t.Errorf("missing authorization header")
+		}
+
+		skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+
+		page := collection{Total: 250, Skip: skip}
+		for i := skip; i < skip+collectionPageSize && i < 250; i++ {
+			page.Items = append(page.Items, json.RawMessage(fmt.Sprintf(`{"sys":{"id":"%d"}}`, i)))
+		}
+
+		json.NewEncoder(w).Encode(page)
+	})
+	defer teardown()
+
+	count := 0
+	err := cmaList(client, "/spaces/s/entries", url.Values{}, 0, func(item json.RawMessage) error {
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if count != 250 {
+		t.Fatalf("expected 250 items, got %d", count)
+	}
+
+	count = 0
+	err = cmaList(client, "/spaces/s/entries", url.Values{}, 120, func(item json.RawMessage) error {
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if count != 120 {
+		t.Fatalf("expected 120 items, got %d", count)
+	}
+}
+
+func TestCMARequest_RateLimitAndErrors(t *testing.T) {
+	calls := 0
+	client, teardown := testCMAServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		switch {
+		case calls == 1:
+			w.Header().Set("X-Contentful-RateLimit-Reset", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case r.Header.Get("X-Contentful-Version") != "3":
+			t.Errorf("expected version header 3, got %q", r.Header.Get("X-Contentful-Version"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"sys":{"id":"NotFound"},"message":"The resource could not be found.","requestId":"abc"}`)
+		}
+	})
+	defer teardown()
+
+	err := cmaDelete(client, "/spaces/s/entries/e", 3, nil)
+	if !isNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+
+	if calls != 2 {
+		t.Fatalf("expected the rate limited request to be retried once, got %d calls", calls)
+	}
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+func dataSourceContentfulContentType() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceReadContentType,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"environment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "master",
+			},
+			"content_type_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_field": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// The fields in the format of the field blocks of
+			// contentful_contenttype, validations are JSON encoded.
+			"field": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"link_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"items": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"link_type": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"validations": &schema.Schema{
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"required": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"localized": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"disabled": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"omitted": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"validations": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"default_value": &schema.Schema{
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceReadContentType(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+	contentTypeID := d.Get("content_type_id").(string)
+
+	doc := &contentTypeDocument{}
+
+	path := fmt.Sprintf("%s/content_types/%s", environmentPath(spaceID, environment), contentTypeID)
+	if err = cmaGet(client, path, nil, doc); err != nil {
+		return err
+	}
+
+	fields := []interface{}{}
+	for _, rawField := range doc.Fields {
+		field, err := flattenContentTypeField(rawField)
+		if err != nil {
+			return err
+		}
+
+		fields = append(fields, field)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", spaceID, environment, contentTypeID))
+
+	if err = d.Set("version", doc.Sys.Version); err != nil {
+		return err
+	}
+
+	if err = d.Set("name", doc.Name); err != nil {
+		return err
+	}
+
+	if err = d.Set("description", doc.Description); err != nil {
+		return err
+	}
+
+	if err = d.Set("display_field", doc.DisplayField); err != nil {
+		return err
+	}
+
+	return d.Set("field", fields)
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccContentfulContentTypeDataSource_Basic(t *testing.T) {
+	spaceName := fmt.Sprintf("space-name-%s", acctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulContentTypeDataSourceConfig(spaceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.contentful_content_type.page", "name", "Page"),
+					resource.TestCheckResourceAttr("data.contentful_content_type.page", "display_field", "title"),
+					resource.TestCheckResourceAttr("data.contentful_content_type.page", "field.#", "2"),
+					resource.TestCheckResourceAttr("data.contentful_content_type.page", "field.1.items.0.link_type", "Asset"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenContentTypeField(t *testing.T) {
+	field, err := flattenContentTypeField(map[string]interface{}{
+		"id":          "url",
+		"apiName":     "slug",
+		"name":        "Slug",
+		"type":        "Symbol",
+		"required":    true,
+		"validations": []interface{}{map[string]interface{}{"unique": true}},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"id":            "slug",
+		"name":          "Slug",
+		"type":          "Symbol",
+		"link_type":     "",
+		"required":      true,
+		"localized":     false,
+		"disabled":      false,
+		"omitted":       false,
+		"default_value": map[string]interface{}{},
+		"items":         []interface{}{},
+		"validations":   []interface{}{`{"unique":true}`},
+	}
+
+	if !reflect.DeepEqual(field, expected) {
+		t.Fatalf("unexpected field: %#v", field)
+	}
+}
+
+func testAccContentfulContentTypeDataSourceConfig(spaceName string) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  default_locale = "en-US"
+  deletion_protection = false
+}
+
+resource "contentful_contenttype" "page" {
+  space_id = "${contentful_space.myspace.id}"
+  content_type_id = "page"
+
+  name = "Page"
+  display_field = "title"
+
+  field {
+    id = "title"
+    name = "Title"
+    type = "Symbol"
+  }
+
+  field {
+    id = "images"
+    name = "Images"
+    type = "Array"
+
+    items {
+      type = "Link"
+      link_type = "Asset"
+    }
+  }
+}
+
+data "contentful_content_type" "page" {
+  space_id = "${contentful_space.myspace.id}"
+  content_type_id = "${contentful_contenttype.page.id}"
+}
+`, spaceName)
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+func dataSourceContentfulCurrentUser() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceReadCurrentUser,
+
+		Schema: map[string]*schema.Schema{
+			"email": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"first_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// getCurrentUser returns the user the CMA token belongs to.
+func getCurrentUser(client *contentful.Contentful) (*organizationUser, error) {
+	user := &organizationUser{}
+
+	if err := cmaGet(client, "/users/me", nil, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func dataSourceReadCurrentUser(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+
+	user, err := getCurrentUser(client)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(user.Sys.ID)
+
+	if err = d.Set("email", user.Email); err != nil {
+		return err
+	}
+
+	if err = d.Set("first_name", user.FirstName); err != nil {
+		return err
+	}
+
+	return d.Set("last_name", user.LastName)
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccContentfulCurrentUserDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulCurrentUserDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.contentful_current_user.me", "id"),
+					resource.TestCheckResourceAttrSet("data.contentful_current_user.me", "email"),
+				),
+			},
+		},
+	})
+}
+
+var testAccContentfulCurrentUserDataSourceConfig = `
+data "contentful_current_user" "me" {}
+`
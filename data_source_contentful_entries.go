@@ -0,0 +1,232 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+// maxQueriedEntries is the highest limit of the contentful_entries data
+// source, so a query cannot load an entire space into the state.
+const maxQueriedEntries = 1000
+
+func dataSourceContentfulEntries() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceReadEntries,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"environment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "master",
+			},
+			"content_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Field values the entries must have, keyed by field ID. Requires
+			// content_type.
+			"field_equals": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			// Only entries with one of these IDs.
+			"entry_ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// Only entries with one of these tags.
+			"tags": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"order": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "sys.createdAt",
+			},
+			"limit": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(1, maxQueriedEntries),
+			},
+			// The number of entries matching the query, which is greater
+			// than the number of entries returned when limit was reached.
+			"total": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"entries": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"content_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"published": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"tags": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						// The fields keyed by field ID and locale, e.g.
+						// {"title":{"en-US":"Home"}}.
+						"fields_json": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// buildEntriesQuery returns the CMA query parameters of the data source
+// arguments.
+func buildEntriesQuery(contentType string, fieldEquals map[string]interface{}, entryIDs, tags []interface{}, order string) (url.Values, error) {
+	query := url.Values{}
+
+	if len(fieldEquals) > 0 && contentType == "" {
+		return nil, fmt.Errorf("field_equals requires content_type")
+	}
+
+	if contentType != "" {
+		query.Set("content_type", contentType)
+	}
+
+	for field, value := range fieldEquals {
+		query.Set("fields."+field, value.(string))
+	}
+
+	if len(entryIDs) > 0 {
+		query.Set("sys.id[in]", joinStrings(entryIDs))
+	}
+
+	if len(tags) > 0 {
+		query.Set("metadata.tags.sys.id[in]", joinStrings(tags))
+	}
+
+	if order != "" {
+		query.Set("order", order)
+	}
+
+	return query, nil
+}
+
+func joinStrings(values []interface{}) string {
+	var s []string
+	for _, value := range values {
+		s = append(s, value.(string))
+	}
+	sort.Strings(s)
+
+	return strings.Join(s, ",")
+}
+
+func flattenEntries(entries []*entry) ([]interface{}, error) {
+	flattened := []interface{}{}
+
+	for _, e := range entries {
+		fields, err := json.Marshal(e.Fields)
+		if err != nil {
+			return nil, err
+		}
+
+		contentType := ""
+		if e.Sys.ContentType != nil {
+			contentType = e.Sys.ContentType.Sys.ID
+		}
+
+		flattened = append(flattened, map[string]interface{}{
+			"id":           e.Sys.ID,
+			"content_type": contentType,
+			"version":      e.Sys.Version,
+			"published":    e.Sys.isPublished(),
+			"tags":         metadataTagIDs(e.Metadata),
+			"fields_json":  string(fields),
+		})
+	}
+
+	return flattened, nil
+}
+
+func dataSourceReadEntries(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	query, err := buildEntriesQuery(
+		d.Get("content_type").(string),
+		d.Get("field_equals").(map[string]interface{}),
+		d.Get("entry_ids").([]interface{}),
+		d.Get("tags").([]interface{}),
+		d.Get("order").(string),
+	)
+	if err != nil {
+		return err
+	}
+
+	total, err := countEntries(client, spaceID, environment, query)
+	if err != nil {
+		return err
+	}
+
+	entries, err := listEntries(client, spaceID, environment, query, d.Get("limit").(int))
+	if err != nil {
+		return err
+	}
+
+	flattened, err := flattenEntries(entries)
+	if err != nil {
+		return err
+	}
+
+	ids := []string{}
+	for _, e := range entries {
+		ids = append(ids, e.Sys.ID)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", spaceID, environment, query.Encode()))
+
+	if err = d.Set("total", total); err != nil {
+		return err
+	}
+
+	if err = d.Set("ids", ids); err != nil {
+		return err
+	}
+
+	return d.Set("entries", flattened)
+}
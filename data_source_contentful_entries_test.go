@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccContentfulEntriesDataSource_Basic(t *testing.T) {
+	spaceName := fmt.Sprintf("space-name-%s", acctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulEntriesDataSourceConfig(spaceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.contentful_entries.home", "total", "1"),
+					resource.TestCheckResourceAttr("data.contentful_entries.home", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.contentful_entries.home", "ids.0", "home"),
+					resource.TestCheckResourceAttr("data.contentful_entries.home", "entries.0.content_type", "page"),
+					resource.TestCheckResourceAttr("data.contentful_entries.home", "entries.0.fields_json", `{"title":{"en-US":"Home"}}`),
+					resource.TestCheckResourceAttr("data.contentful_entries.limited", "total", "2"),
+					resource.TestCheckResourceAttr("data.contentful_entries.limited", "ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestBuildEntriesQuery(t *testing.T) {
+	query, err := buildEntriesQuery(
+		"page",
+		map[string]interface{}{"slug": "home"},
+		[]interface{}{"b", "a"},
+		[]interface{}{"nav"},
+		"-sys.updatedAt",
+	)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := "content_type=page&fields.slug=home&metadata.tags.sys.id%5Bin%5D=nav&order=-sys.updatedAt&sys.id%5Bin%5D=a%2Cb"
+	if encoded := query.Encode(); encoded != expected {
+		t.Fatalf("expected %s, got %s", expected, encoded)
+	}
+
+	if _, err = buildEntriesQuery("", map[string]interface{}{"slug": "home"}, nil, nil, ""); err == nil {
+		t.Fatal("expected field_equals without content_type to fail")
+	}
+}
+
+func testAccContentfulEntriesDataSourceConfig(spaceName string) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  default_locale = "en-US"
+  deletion_protection = false
+}
+
+resource "contentful_contenttype" "page" {
+  space_id = "${contentful_space.myspace.id}"
+  content_type_id = "page"
+
+  name = "Page"
+  display_field = "title"
+
+  field {
+    id = "title"
+    name = "Title"
+    type = "Symbol"
+  }
+}
+
+resource "contentful_entry" "home" {
+  space_id = "${contentful_space.myspace.id}"
+  entry_id = "home"
+  content_type_id = "${contentful_contenttype.page.id}"
+
+  field {
+    id = "title"
+    locale = "en-US"
+    content = "\"Home\""
+  }
+}
+
+resource "contentful_entry" "about" {
+  space_id = "${contentful_space.myspace.id}"
+  entry_id = "about"
+  content_type_id = "${contentful_contenttype.page.id}"
+
+  field {
+    id = "title"
+    locale = "en-US"
+    content = "\"About\""
+  }
+}
+
+data "contentful_entries" "home" {
+  space_id = "${contentful_space.myspace.id}"
+  content_type = "page"
+
+  field_equals {
+    title = "Home"
+  }
+
+  depends_on = ["contentful_entry.home", "contentful_entry.about"]
+}
+
+data "contentful_entries" "limited" {
+  space_id = "${contentful_space.myspace.id}"
+  content_type = "page"
+  limit = 1
+
+  depends_on = ["contentful_entry.home", "contentful_entry.about"]
+}
+`, spaceName)
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+func dataSourceContentfulLocales() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceReadLocales,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"environment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "master",
+			},
+			// The codes of all locales, sorted.
+			"codes": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"default_locale": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// The locales in the order of codes.
+			"locales": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"code": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fallback_code": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"optional": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"cda": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"cma": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// sortLocales sorts the locales by code, the order of the API depends on when
+// the locales were created.
+func sortLocales(locales []*locale) {
+	sort.Slice(locales, func(i, j int) bool {
+		return locales[i].Code < locales[j].Code
+	})
+}
+
+func flattenLocales(locales []*locale) []interface{} {
+	flattened := []interface{}{}
+
+	for _, l := range locales {
+		flattened = append(flattened, map[string]interface{}{
+			"id":            l.Sys.ID,
+			"code":          l.Code,
+			"name":          l.Name,
+			"fallback_code": l.FallbackCode,
+			"default":       l.Default,
+			"optional":      l.Optional,
+			"cda":           l.CDA,
+			"cma":           l.CMA,
+		})
+	}
+
+	return flattened
+}
+
+func dataSourceReadLocales(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	locales, err := listLocales(client, spaceID, environment)
+	if err != nil {
+		return err
+	}
+
+	sortLocales(locales)
+
+	codes := []string{}
+	defaultLocale := ""
+	for _, l := range locales {
+		codes = append(codes, l.Code)
+
+		if l.Default {
+			defaultLocale = l.Code
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", spaceID, environment))
+
+	if err = d.Set("codes", codes); err != nil {
+		return err
+	}
+
+	if err = d.Set("default_locale", defaultLocale); err != nil {
+		return err
+	}
+
+	return d.Set("locales", flattenLocales(locales))
+}
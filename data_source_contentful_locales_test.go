@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccContentfulLocalesDataSource_Basic(t *testing.T) {
+	spaceName := fmt.Sprintf("space-name-%s", acctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulLocalesDataSourceConfig(spaceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.contentful_locales.all", "codes.#", "2"),
+					resource.TestCheckResourceAttr("data.contentful_locales.all", "codes.0", "de-DE"),
+					resource.TestCheckResourceAttr("data.contentful_locales.all", "codes.1", "en-US"),
+					resource.TestCheckResourceAttr("data.contentful_locales.all", "default_locale", "en-US"),
+					resource.TestCheckResourceAttr("data.contentful_locales.all", "locales.0.fallback_code", "en-US"),
+					resource.TestCheckResourceAttr("data.contentful_locales.all", "locales.1.default", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestSortLocales(t *testing.T) {
+	locales := []*locale{{Code: "en-US"}, {Code: "de-DE"}, {Code: "fr-FR"}}
+
+	sortLocales(locales)
+
+	var codes []string
+	for _, l := range locales {
+		codes = append(codes, l.Code)
+	}
+
+	if expected := []string{"de-DE", "en-US", "fr-FR"}; !reflect.DeepEqual(codes, expected) {
+		t.Fatalf("expected %v, got %v", expected, codes)
+	}
+}
+
+func testAccContentfulLocalesDataSourceConfig(spaceName string) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  default_locale = "en-US"
+  deletion_protection = false
+}
+
+resource "contentful_locale" "german" {
+  space_id = "${contentful_space.myspace.id}"
+
+  name = "German"
+  code = "de-DE"
+  fallback_code = "en-US"
+}
+
+data "contentful_locales" "all" {
+  space_id = "${contentful_locale.german.space_id}"
+}
+`, spaceName)
+}
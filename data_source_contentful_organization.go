@@ -0,0 +1,105 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+// dataSourceContentfulOrganization describes the organization configured in
+// the provider. The plan of the organization is not part of the Content
+// Management API, so it is not available here.
+func dataSourceContentfulOrganization() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceReadOrganization,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"spaces": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type organization struct {
+	Sys  entitySys `json:"sys"`
+	Name string    `json:"name"`
+}
+
+// getOrganization returns the configured organization. There is no endpoint
+// for a single organization, so it is looked up among the organizations of the
+// user.
+func getOrganization(client *contentful.Contentful) (*organization, error) {
+	var found *organization
+
+	err := cmaList(client, "/organizations", nil, 0, func(item json.RawMessage) error {
+		o := &organization{}
+		if err := json.Unmarshal(item, o); err != nil {
+			return err
+		}
+
+		if o.Sys.ID == organizationID(client) {
+			found = o
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("Organization %s not found or not accessible with the CMA token", organizationID(client))
+	}
+
+	return found, nil
+}
+
+func dataSourceReadOrganization(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+
+	o, err := getOrganization(client)
+	if err != nil {
+		return err
+	}
+
+	spaces, err := listOrganizationSpaces(client)
+	if err != nil {
+		return err
+	}
+
+	var flattened []interface{}
+	for _, s := range spaces {
+		flattened = append(flattened, map[string]interface{}{
+			"id":   s.Sys.ID,
+			"name": s.Name,
+		})
+	}
+
+	d.SetId(o.Sys.ID)
+
+	if err = d.Set("name", o.Name); err != nil {
+		return err
+	}
+
+	return d.Set("spaces", flattened)
+}
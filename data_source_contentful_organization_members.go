@@ -0,0 +1,76 @@
+package main
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+func dataSourceContentfulOrganizationMembers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceReadOrganizationMembers,
+
+		Schema: map[string]*schema.Schema{
+			"members": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"first_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceReadOrganizationMembers(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+
+	members, err := listOrganizationMembers(client)
+	if err != nil {
+		return err
+	}
+
+	var flattened []interface{}
+	for _, member := range members {
+		flattened = append(flattened, map[string]interface{}{
+			"id":         member.ID,
+			"user_id":    member.UserID,
+			"email":      member.Email,
+			"first_name": member.FirstName,
+			"last_name":  member.LastName,
+			"role":       member.Role,
+		})
+	}
+
+	if err = d.Set("members", flattened); err != nil {
+		return err
+	}
+
+	d.SetId(organizationID(client))
+
+	return nil
+}
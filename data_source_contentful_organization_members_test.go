@@ -0,0 +1,29 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccContentfulOrganizationMembersDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulOrganizationMembersDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.contentful_organization_members.members", "members.#"),
+					resource.TestCheckResourceAttrSet(
+						"data.contentful_organization_members.members", "members.0.email"),
+				),
+			},
+		},
+	})
+}
+
+var testAccContentfulOrganizationMembersDataSourceConfig = `
+data "contentful_organization_members" "members" {}
+`
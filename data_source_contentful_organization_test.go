@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccContentfulOrganizationDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulOrganizationDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.contentful_organization.org", "name"),
+					resource.TestCheckResourceAttrSet("data.contentful_organization.org", "spaces.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestGetOrganization(t *testing.T) {
+	client, teardown := testCMAServer(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total": 2, "items": [
+  {"sys": {"id": "other"}, "name": "Other"},
+  {"sys": {"id": "org"}, "name": "Acme"}
+]}`)
+	})
+	defer teardown()
+
+	client.SetOrganization("org")
+
+	o, err := getOrganization(client)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if o.Name != "Acme" {
+		t.Fatalf("expected Acme, got %s", o.Name)
+	}
+
+	client.SetOrganization("missing")
+
+	if _, err = getOrganization(client); err == nil {
+		t.Fatal("expected an error for an inaccessible organization")
+	}
+}
+
+var testAccContentfulOrganizationDataSourceConfig = `
+data "contentful_organization" "org" {}
+`
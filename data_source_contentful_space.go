@@ -0,0 +1,253 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+func dataSourceContentfulSpace() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceReadSpace,
+
+		Schema: map[string]*schema.Schema{
+			// The space is looked up by ID, or by its exact name.
+			"space_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+			},
+			"name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"space_id"},
+			},
+			"version": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"default_locale": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// IDs of the environments of the space.
+			"environments": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// Codes of the locales of the master environment.
+			"locales": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+type space struct {
+	Sys struct {
+		entitySys
+		Organization *link `json:"organization,omitempty"`
+	} `json:"sys"`
+	Name string `json:"name"`
+}
+
+type environment struct {
+	Sys  entitySys `json:"sys"`
+	Name string    `json:"name"`
+}
+
+type locale struct {
+	Sys          entitySys `json:"sys"`
+	Code         string    `json:"code"`
+	Name         string    `json:"name"`
+	Default      bool      `json:"default"`
+	FallbackCode string    `json:"fallbackCode"`
+	Optional     bool      `json:"optional"`
+	CDA          bool      `json:"contentDeliveryApi"`
+	CMA          bool      `json:"contentManagementApi"`
+}
+
+// inOrganization reports whether the space belongs to the configured
+// organization.
+func (s *space) inOrganization(client *contentful.Contentful) bool {
+	return s.Sys.Organization != nil && s.Sys.Organization.Sys.ID == organizationID(client)
+}
+
+// getOrganizationSpace returns the space with the given ID. Like lookups by
+// name, it fails for spaces of other organizations the token has access to.
+func getOrganizationSpace(client *contentful.Contentful, spaceID string) (*space, error) {
+	s := &space{}
+
+	if err := cmaGet(client, environmentPath(spaceID, ""), nil, s); err != nil {
+		return nil, err
+	}
+
+	if !s.inOrganization(client) {
+		return nil, fmt.Errorf("Space %s does not belong to organization %s", spaceID, organizationID(client))
+	}
+
+	return s, nil
+}
+
+// listOrganizationSpaces returns the spaces of the configured organization.
+// The token can have access to spaces of other organizations as well.
+func listOrganizationSpaces(client *contentful.Contentful) ([]*space, error) {
+	var spaces []*space
+
+	err := cmaList(client, "/spaces", nil, 0, func(item json.RawMessage) error {
+		s := &space{}
+		if err := json.Unmarshal(item, s); err != nil {
+			return err
+		}
+
+		if !s.inOrganization(client) {
+			return nil
+		}
+
+		spaces = append(spaces, s)
+		return nil
+	})
+
+	return spaces, err
+}
+
+// findSpaceByName returns the space of the organization with exactly the
+// given name. It fails when none or several spaces have the name.
+func findSpaceByName(client *contentful.Contentful, name string) (*space, error) {
+	spaces, err := listOrganizationSpaces(client)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*space
+	for _, s := range spaces {
+		if s.Name == name {
+			matches = append(matches, s)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("No space named %q found in organization %s", name, organizationID(client))
+	case 1:
+		return matches[0], nil
+	}
+
+	var ids []string
+	for _, s := range matches {
+		ids = append(ids, s.Sys.ID)
+	}
+
+	return nil, fmt.Errorf(
+		"Found %d spaces named %q in organization %s: %s. Use space_id instead",
+		len(matches), name, organizationID(client), strings.Join(ids, ", "),
+	)
+}
+
+// listLocales returns the locales of the environment.
+func listLocales(client *contentful.Contentful, spaceID, environment string) ([]*locale, error) {
+	var locales []*locale
+
+	err := cmaList(client, environmentPath(spaceID, environment)+"/locales", nil, 0, func(item json.RawMessage) error {
+		l := &locale{}
+		if err := json.Unmarshal(item, l); err != nil {
+			return err
+		}
+
+		locales = append(locales, l)
+		return nil
+	})
+
+	return locales, err
+}
+
+func listEnvironments(client *contentful.Contentful, spaceID string) ([]*environment, error) {
+	var environments []*environment
+
+	err := cmaList(client, environmentPath(spaceID, "")+"/environments", nil, 0, func(item json.RawMessage) error {
+		e := &environment{}
+		if err := json.Unmarshal(item, e); err != nil {
+			return err
+		}
+
+		environments = append(environments, e)
+		return nil
+	})
+
+	return environments, err
+}
+
+func dataSourceReadSpace(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+
+	var s *space
+
+	if spaceID := d.Get("space_id").(string); spaceID != "" {
+		s, err = getOrganizationSpace(client, spaceID)
+	} else if name := d.Get("name").(string); name != "" {
+		s, err = findSpaceByName(client, name)
+	} else {
+		err = fmt.Errorf("One of space_id or name is required")
+	}
+
+	if err != nil {
+		return err
+	}
+
+	environments, err := listEnvironments(client, s.Sys.ID)
+	if err != nil {
+		return err
+	}
+
+	locales, err := listLocales(client, s.Sys.ID, "master")
+	if err != nil {
+		return err
+	}
+
+	d.SetId(s.Sys.ID)
+
+	if err = d.Set("space_id", s.Sys.ID); err != nil {
+		return err
+	}
+
+	if err = d.Set("name", s.Name); err != nil {
+		return err
+	}
+
+	if err = d.Set("version", s.Sys.Version); err != nil {
+		return err
+	}
+
+	environmentIDs := []string{}
+	for _, e := range environments {
+		environmentIDs = append(environmentIDs, e.Sys.ID)
+	}
+
+	if err = d.Set("environments", environmentIDs); err != nil {
+		return err
+	}
+
+	codes := []string{}
+	defaultLocale := ""
+	for _, l := range locales {
+		codes = append(codes, l.Code)
+
+		if l.Default {
+			defaultLocale = l.Code
+		}
+	}
+
+	if err = d.Set("locales", codes); err != nil {
+		return err
+	}
+
+	return d.Set("default_locale", defaultLocale)
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccContentfulSpaceDataSource_Basic(t *testing.T) {
+	spaceName := fmt.Sprintf("space-name-%s", acctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulSpaceDataSourceConfig(spaceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.contentful_space.by_name", "space_id", "contentful_space.myspace", "id"),
+					resource.TestCheckResourceAttr("data.contentful_space.by_id", "name", spaceName),
+					resource.TestCheckResourceAttr("data.contentful_space.by_id", "default_locale", "en-US"),
+					resource.TestCheckResourceAttr("data.contentful_space.by_id", "environments.0", "master"),
+					resource.TestCheckResourceAttr("data.contentful_space.by_id", "locales.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestFindSpaceByName(t *testing.T) {
+	client, teardown := testCMAServer(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total": 3, "items": [
+  {"sys": {"id": "one", "organization": {"sys": {"id": "org"}}}, "name": "Blog"},
+  {"sys": {"id": "two", "organization": {"sys": {"id": "org"}}}, "name": "Blog"},
+  {"sys": {"id": "three", "organization": {"sys": {"id": "other"}}}, "name": "Shop"}
+]}`)
+	})
+	defer teardown()
+
+	client.SetOrganization("org")
+
+	if _, err := findSpaceByName(client, "Blog"); err == nil || !strings.Contains(err.Error(), "one, two") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Spaces of other organizations are ignored.
+	if _, err := findSpaceByName(client, "Shop"); err == nil {
+		t.Fatal("expected an error for a space of another organization")
+	}
+}
+
+func TestGetOrganizationSpace(t *testing.T) {
+	client, teardown := testCMAServer(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"sys": {"id": "blog", "organization": {"sys": {"id": "org"}}}, "name": "Blog"}`)
+	})
+	defer teardown()
+
+	client.SetOrganization("org")
+
+	s, err := getOrganizationSpace(client, "blog")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if s.Name != "Blog" {
+		t.Fatalf("expected Blog, got %s", s.Name)
+	}
+
+	client.SetOrganization("other")
+
+	if _, err = getOrganizationSpace(client, "blog"); err == nil || !strings.Contains(err.Error(), "does not belong to organization other") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func testAccContentfulSpaceDataSourceConfig(spaceName string) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  default_locale = "en-US"
+  deletion_protection = false
+}
+
+data "contentful_space" "by_name" {
+  name = "${contentful_space.myspace.name}"
+}
+
+data "contentful_space" "by_id" {
+  space_id = "${contentful_space.myspace.id}"
+}
+`, spaceName)
+}
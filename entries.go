@@ -0,0 +1,105 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+// entry is an entry as returned by the Content Management API. Fields are
+// keyed by field ID and locale code.
+type entry struct {
+	Sys      *entitySys                        `json:"sys,omitempty"`
+	Metadata *entityMetadata                   `json:"metadata,omitempty"`
+	Fields   map[string]map[string]interface{} `json:"fields"`
+}
+
+func entriesPath(spaceID, environment string) string {
+	return environmentPath(spaceID, environment) + "/entries"
+}
+
+func entryPath(spaceID, environment, entryID string) string {
+	return fmt.Sprintf("%s/%s", entriesPath(spaceID, environment), entryID)
+}
+
+// listEntries returns all entries matching the query, up to max when max is
+// positive.
+func listEntries(client *contentful.Contentful, spaceID, environment string, query url.Values, max int) ([]*entry, error) {
+	var entries []*entry
+
+	err := cmaList(client, entriesPath(spaceID, environment), query, max, func(item json.RawMessage) error {
+		e := &entry{}
+		if err := json.Unmarshal(item, e); err != nil {
+			return err
+		}
+
+		entries = append(entries, e)
+		return nil
+	})
+
+	return entries, err
+}
+
+func countEntries(client *contentful.Contentful, spaceID, environment string, query url.Values) (int, error) {
+	return cmaCount(client, entriesPath(spaceID, environment), query)
+}
+
+// saveEntry creates or updates the entry. Entries without an ID are created
+// with a generated one, entries with an ID but no version are created with
+// that ID.
+func saveEntry(client *contentful.Contentful, spaceID, environment, contentTypeID string, e *entry) error {
+	method := http.MethodPut
+	path := entriesPath(spaceID, environment)
+
+	if e.Sys == nil || e.Sys.ID == "" {
+		method = http.MethodPost
+	} else {
+		path = entryPath(spaceID, environment, e.Sys.ID)
+	}
+
+	req, err := newCMARequest(client, method, path, nil, e)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Content-Type", contentTypeID)
+
+	if e.Sys != nil && e.Sys.Version > 0 {
+		setVersion(req, e.Sys.Version)
+	}
+
+	return doCMARequest(client, req, e)
+}
+
+// publishEntry publishes the entry and updates its sys from the response.
+func publishEntry(client *contentful.Contentful, spaceID, environment string, e *entry) error {
+	path := entryPath(spaceID, environment, e.Sys.ID) + "/published"
+
+	return cmaPut(client, path, e.Sys.Version, nil, e)
+}
+
+// unpublishEntry unpublishes the entry and updates its sys from the response.
+func unpublishEntry(client *contentful.Contentful, spaceID, environment string, e *entry) error {
+	path := entryPath(spaceID, environment, e.Sys.ID) + "/published"
+
+	return cmaDelete(client, path, e.Sys.Version, e)
+}
+
+// deleteEntry unpublishes the entry when needed and deletes it.
+func deleteEntry(client *contentful.Contentful, spaceID, environment string, e *entry) error {
+	if e.Sys.isPublished() {
+		if err := unpublishEntry(client, spaceID, environment, e); err != nil {
+			return err
+		}
+	}
+
+	err := cmaDelete(client, entryPath(spaceID, environment, e.Sys.ID), e.Sys.Version, nil)
+	if isNotFound(err) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// importSpaceScoped imports resources that live in a space by an ID of the
+// form <space_id>/<resource_id>.
+func importSpaceScoped(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Unexpected import ID %q, expected <space_id>/<id>", d.Id())
+	}
+
+	if err := d.Set("space_id", parts[0]); err != nil {
+		return nil, err
+	}
+
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// importEnvironmentScoped imports resources that live in an environment by an
+// ID of the form <space_id>/<environment>/<resource_id>, or <space_id>/<id>
+// for resources in the master environment.
+func importEnvironmentScoped(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) == 2 {
+		parts = []string{parts[0], "master", parts[1]}
+	}
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("Unexpected import ID %q, expected <space_id>/<environment>/<id>", d.Id())
+	}
+
+	if err := d.Set("space_id", parts[0]); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("environment", parts[1]); err != nil {
+		return nil, err
+	}
+
+	d.SetId(parts[2])
+
+	return []*schema.ResourceData{d}, nil
+}
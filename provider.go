@@ -26,11 +26,31 @@ func Provider() terraform.ResourceProvider {
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"contentful_space":       resourceContentfulSpace(),
-			"contentful_contenttype": resourceContentfulContentType(),
-			"contentful_apikey":      resourceContentfulAPIKey(),
-			"contentful_webhook":     resourceContentfulWebhook(),
-			"contentful_locale":      resourceContentfulLocale(),
+			"contentful_space":                   resourceContentfulSpace(),
+			"contentful_contenttype":             resourceContentfulContentType(),
+			"contentful_apikey":                  resourceContentfulAPIKey(),
+			"contentful_webhook":                 resourceContentfulWebhook(),
+			"contentful_locale":                  resourceContentfulLocale(),
+			"contentful_space_membership":        resourceContentfulSpaceMembership(),
+			"contentful_role":                    resourceContentfulRole(),
+			"contentful_team":                    resourceContentfulTeam(),
+			"contentful_team_space_membership":   resourceContentfulTeamSpaceMembership(),
+			"contentful_team_membership":         resourceContentfulTeamMembership(),
+			"contentful_organization_membership": resourceContentfulOrganizationMembership(),
+			"contentful_entry":                   resourceContentfulEntry(),
+			"contentful_asset":                   resourceContentfulAsset(),
+			"contentful_tag":                     resourceContentfulTag(),
+			"contentful_editor_interface":        resourceContentfulEditorInterface(),
+			"contentful_ui_extension":            resourceContentfulUIExtension(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"contentful_organization_members": dataSourceContentfulOrganizationMembers(),
+			"contentful_space":                dataSourceContentfulSpace(),
+			"contentful_content_type":         dataSourceContentfulContentType(),
+			"contentful_locales":              dataSourceContentfulLocales(),
+			"contentful_entries":              dataSourceContentfulEntries(),
+			"contentful_current_user":         dataSourceContentfulCurrentUser(),
+			"contentful_organization":         dataSourceContentfulOrganization(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
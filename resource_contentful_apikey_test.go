@@ -126,6 +126,7 @@ func testAccContentfulAPIKeyConfig(spaceName, name, description string) string {
 	return fmt.Sprintf(`
 resource "contentful_space" "myspace" {
   name = "%s"
+  deletion_protection = false
 }
 
 resource "contentful_apikey" "myapikey" {
@@ -141,6 +142,7 @@ func testAccContentfulAPIKeyUpdateConfig(spaceName, name, description string) st
 	return fmt.Sprintf(`
 resource "contentful_space" "myspace" {
   name = "%s"
+  deletion_protection = false
 }
 
 resource "contentful_apikey" "myapikey" {
@@ -0,0 +1,429 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+// assetProcessingTimeout is how long we wait for Contentful to process an
+// uploaded file.
+const assetProcessingTimeout = 5 * time.Minute
+
+func resourceContentfulAsset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreateAsset,
+		Read:   resourceReadAsset,
+		Update: resourceUpdateAsset,
+		Delete: resourceDeleteAsset,
+
+		CustomizeDiff: resourceAssetCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"version": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"space_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "master",
+			},
+			// The ID is generated by Contentful unless it is given here.
+			"asset_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			// Titles and descriptions are keyed by locale code.
+			"title": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"file": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"locale": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						// Path of the local file to upload.
+						"path": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"content_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						// Defaults to the base name of path.
+						"file_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			// SHA-256 of the uploaded file, a change triggers a new upload.
+			"file_hash": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// IDs of the contentful_tag resources attached to the asset.
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"published": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+type asset struct {
+	Sys      *entitySys      `json:"sys,omitempty"`
+	Metadata *entityMetadata `json:"metadata,omitempty"`
+	Fields   assetFields     `json:"fields"`
+}
+
+type assetFields struct {
+	Title       map[string]interface{} `json:"title,omitempty"`
+	Description map[string]interface{} `json:"description,omitempty"`
+	File        map[string]*assetFile  `json:"file,omitempty"`
+}
+
+type assetFile struct {
+	FileName    string `json:"fileName"`
+	ContentType string `json:"contentType"`
+	UploadFrom  *link  `json:"uploadFrom,omitempty"`
+	URL         string `json:"url,omitempty"`
+}
+
+func assetsPath(spaceID, environment string) string {
+	return environmentPath(spaceID, environment) + "/assets"
+}
+
+func assetPath(spaceID, environment, assetID string) string {
+	return fmt.Sprintf("%s/%s", assetsPath(spaceID, environment), assetID)
+}
+
+// fileSHA256 returns the hex encoded SHA-256 of a local file.
+func fileSHA256(path string) (string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// resourceAssetCustomizeDiff hashes the local file, so changing its content
+// plans a new upload even though the path stays the same.
+func resourceAssetCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	path, ok := d.Get("file.0.path").(string)
+	if !ok || path == "" || !d.NewValueKnown("file.0.path") {
+		return nil
+	}
+
+	hash, err := fileSHA256(path)
+	if err != nil {
+		return fmt.Errorf("Reading the file of the asset: %s", err)
+	}
+
+	if hash != d.Get("file_hash").(string) {
+		return d.SetNew("file_hash", hash)
+	}
+
+	return nil
+}
+
+func resourceCreateAsset(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	a := &asset{
+		Metadata: newEntityMetadata(d.Get("tags").(*schema.Set)),
+		Fields:   buildAssetFields(d),
+	}
+
+	if assetID := d.Get("asset_id").(string); assetID != "" {
+		a.Sys = &entitySys{ID: assetID}
+	}
+
+	hash, err := uploadAssetFile(client, d, a)
+	if err != nil {
+		return err
+	}
+
+	if err = saveAsset(client, spaceID, environment, a); err != nil {
+		return err
+	}
+
+	d.SetId(a.Sys.ID)
+
+	if err = d.Set("file_hash", hash); err != nil {
+		return err
+	}
+
+	if err = processAsset(client, spaceID, environment, d.Get("file.0.locale").(string), a); err != nil {
+		return err
+	}
+
+	if d.Get("published").(bool) {
+		if err = cmaPut(client, assetPath(spaceID, environment, a.Sys.ID)+"/published", a.Sys.Version, nil, a); err != nil {
+			return err
+		}
+	}
+
+	return setAssetProperties(d, a)
+}
+
+func resourceReadAsset(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	a := &asset{}
+
+	err = cmaGet(client, assetPath(spaceID, environment, d.Id()), nil, a)
+	if isNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return setAssetProperties(d, a)
+}
+
+func resourceUpdateAsset(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+	path := assetPath(spaceID, environment, d.Id())
+
+	current := &asset{}
+	if err = cmaGet(client, path, nil, current); err != nil {
+		return err
+	}
+
+	a := &asset{
+		Sys:      current.Sys,
+		Metadata: newEntityMetadata(d.Get("tags").(*schema.Set)),
+		Fields:   buildAssetFields(d),
+	}
+	a.Fields.File = current.Fields.File
+
+	reupload := d.HasChange("file") || d.HasChange("file_hash")
+	if reupload {
+		hash, err := uploadAssetFile(client, d, a)
+		if err != nil {
+			return err
+		}
+
+		if err = d.Set("file_hash", hash); err != nil {
+			return err
+		}
+	}
+
+	if err = saveAsset(client, spaceID, environment, a); err != nil {
+		return err
+	}
+
+	if reupload {
+		if err = processAsset(client, spaceID, environment, d.Get("file.0.locale").(string), a); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("published").(bool) {
+		if !a.Sys.isPublished() || a.Sys.hasUnpublishedChanges() {
+			err = cmaPut(client, path+"/published", a.Sys.Version, nil, a)
+		}
+	} else if a.Sys.isPublished() {
+		err = cmaDelete(client, path+"/published", a.Sys.Version, a)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return setAssetProperties(d, a)
+}
+
+func resourceDeleteAsset(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+	path := assetPath(spaceID, environment, d.Id())
+
+	a := &asset{}
+
+	err = cmaGet(client, path, nil, a)
+	if isNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if a.Sys.isPublished() {
+		if err = cmaDelete(client, path+"/published", a.Sys.Version, a); err != nil {
+			return err
+		}
+	}
+
+	err = cmaDelete(client, path, a.Sys.Version, nil)
+	if isNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+func buildAssetFields(d *schema.ResourceData) assetFields {
+	return assetFields{
+		Title:       d.Get("title").(map[string]interface{}),
+		Description: d.Get("description").(map[string]interface{}),
+		File:        map[string]*assetFile{},
+	}
+}
+
+// uploadAssetFile uploads the local file and points the file of the asset in
+// the configured locale to the upload. It returns the hash of the file.
+func uploadAssetFile(client *contentful.Contentful, d *schema.ResourceData, a *asset) (string, error) {
+	path := d.Get("file.0.path").(string)
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	uploadID, err := cmaUpload(client, d.Get("space_id").(string), data)
+	if err != nil {
+		return "", err
+	}
+
+	fileName := d.Get("file.0.file_name").(string)
+	if fileName == "" {
+		fileName = filepath.Base(path)
+	}
+
+	if a.Fields.File == nil {
+		a.Fields.File = map[string]*assetFile{}
+	}
+
+	a.Fields.File[d.Get("file.0.locale").(string)] = &assetFile{
+		FileName:    fileName,
+		ContentType: d.Get("file.0.content_type").(string),
+		UploadFrom:  newLink("Upload", uploadID),
+	}
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func saveAsset(client *contentful.Contentful, spaceID, environment string, a *asset) error {
+	if a.Sys == nil || a.Sys.ID == "" {
+		return cmaPost(client, assetsPath(spaceID, environment), a, a)
+	}
+
+	return cmaPut(client, assetPath(spaceID, environment, a.Sys.ID), a.Sys.Version, a, a)
+}
+
+// processAsset triggers the processing of the uploaded file and waits until
+// Contentful has stored it, which is when the file gets its URL.
+func processAsset(client *contentful.Contentful, spaceID, environment, locale string, a *asset) error {
+	path := assetPath(spaceID, environment, a.Sys.ID)
+
+	req, err := newCMARequest(client, http.MethodPut, fmt.Sprintf("%s/files/%s/process", path, locale), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	setVersion(req, a.Sys.Version)
+
+	if err = doCMARequest(client, req, nil); err != nil {
+		return err
+	}
+
+	return resource.Retry(assetProcessingTimeout, func() *resource.RetryError {
+		if err := cmaGet(client, path, nil, a); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if file, ok := a.Fields.File[locale]; !ok || file.URL == "" {
+			return resource.RetryableError(fmt.Errorf("The file of asset %s is still being processed", a.Sys.ID))
+		}
+
+		return nil
+	})
+}
+
+func setAssetProperties(d *schema.ResourceData, a *asset) error {
+	if err := d.Set("version", a.Sys.Version); err != nil {
+		return err
+	}
+
+	if err := d.Set("asset_id", a.Sys.ID); err != nil {
+		return err
+	}
+
+	if err := d.Set("title", a.Fields.Title); err != nil {
+		return err
+	}
+
+	if err := d.Set("description", a.Fields.Description); err != nil {
+		return err
+	}
+
+	if err := d.Set("tags", metadataTagIDs(a.Metadata)); err != nil {
+		return err
+	}
+
+	if file, ok := a.Fields.File[d.Get("file.0.locale").(string)]; ok {
+		if err := d.Set("url", file.URL); err != nil {
+			return err
+		}
+	}
+
+	return d.Set("published", a.Sys.isPublished() && !a.Sys.hasUnpublishedChanges())
+}
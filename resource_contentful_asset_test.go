@@ -0,0 +1,129 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+func TestAccContentfulAsset_Basic(t *testing.T) {
+	spaceName := fmt.Sprintf("space-name-%s", acctest.RandString(3))
+
+	file, err := ioutil.TempFile("", "contentful-asset")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.Remove(file.Name())
+
+	writeFile := func(content string) func() {
+		return func() {
+			if err := ioutil.WriteFile(file.Name(), []byte(content), 0644); err != nil {
+				t.Fatalf("err: %s", err)
+			}
+		}
+	}
+
+	writeFile("first version")()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContentfulAssetDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulAssetConfig(spaceName, file.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("contentful_asset.myasset", "url"),
+					resource.TestCheckResourceAttr("contentful_asset.myasset", "published", "true"),
+					resource.TestCheckResourceAttr("contentful_asset.myasset", "title.en-US", "Logo"),
+				),
+			},
+			resource.TestStep{
+				PreConfig: writeFile("second version"),
+				Config:    testAccContentfulAssetConfig(spaceName, file.Name()),
+				Check: resource.TestCheckResourceAttr(
+					"contentful_asset.myasset", "file_hash",
+					"ebfa015966891a400bf353bdf8ef30444a71b1751e2808ef6c014db34d168d85"),
+			},
+		},
+	})
+}
+
+func TestFileSHA256(t *testing.T) {
+	file, err := ioutil.TempFile("", "contentful-asset")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err = file.WriteString("hello"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	file.Close()
+
+	hash, err := fileSHA256(file.Name())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if hash != "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824" {
+		t.Fatalf("unexpected hash: %s", hash)
+	}
+}
+
+func testAccCheckContentfulAssetDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*contentful.Contentful)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_asset" {
+			continue
+		}
+
+		spaceID := rs.Primary.Attributes["space_id"]
+		environment := rs.Primary.Attributes["environment"]
+
+		err := cmaGet(client, assetPath(spaceID, environment, rs.Primary.ID), nil, &asset{})
+		if err == nil {
+			return fmt.Errorf("Asset still exists with id: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccContentfulAssetConfig(spaceName, path string) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  default_locale = "en-US"
+  deletion_protection = false
+}
+
+resource "contentful_asset" "myasset" {
+  space_id = "${contentful_space.myspace.id}"
+
+  title {
+    en-US = "Logo"
+  }
+
+  description {
+    en-US = "The logo of the site"
+  }
+
+  file {
+    locale = "en-US"
+    path = "%s"
+    content_type = "text/plain"
+    file_name = "logo.txt"
+  }
+
+  published = true
+}
+`, spaceName, path)
+}
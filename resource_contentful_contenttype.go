@@ -1,7 +1,15 @@
 package main
 
 import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	contentful "github.com/tolgaakyuz/contentful-go"
 )
 
@@ -12,6 +20,8 @@ func resourceContentfulContentType() *schema.Resource {
 		Update: resourceContentTypeUpdate,
 		Delete: resourceContentTypeDelete,
 
+		CustomizeDiff: resourceContentTypeCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"space_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -22,6 +32,15 @@ func resourceContentfulContentType() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			// The ID is generated by Contentful unless it is given here. A
+			// given ID can be used in link_content_types of other content
+			// types, e.g. when content types link to each other.
+			"content_type_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
@@ -34,9 +53,38 @@ func resourceContentfulContentType() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"deletion_protection": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			// Unpublishes and deletes all entries of the content type before
+			// it is deleted, e.g. to tear down ephemeral environments.
+			"delete_entries_on_destroy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			// Contentful does not allow changing the type of a field. When set,
+			// fields with a new type are deleted and recreated instead, which
+			// drops their content in all entries.
+			"replace_on_type_change": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			// A content type JSON document, e.g. from contentful space export.
+			// Only its fields are used, as an alternative to field blocks.
+			"definition_json": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"field"},
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: suppressContentTypeDefinitionDiff,
+			},
 			"field": &schema.Schema{
 				Type:     schema.TypeSet,
-				Required: true,
+				Optional: true,
 				MinItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -57,6 +105,13 @@ func resourceContentfulContentType() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						// IDs of the content types the entries linked by the
+						// field, or its items, must have.
+						"link_content_types": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
 						"items": &schema.Schema{
 							Type:     schema.TypeSet,
 							Optional: true,
@@ -76,6 +131,11 @@ func resourceContentfulContentType() *schema.Resource {
 										Type:     schema.TypeString,
 										Required: true,
 									},
+									"link_content_types": &schema.Schema{
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
 								},
 							},
 						},
@@ -104,13 +164,140 @@ func resourceContentfulContentType() *schema.Resource {
 							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						// Default values keyed by locale code, e.g.
+						// { "en-US" = "true" } for a Boolean field.
+						"default_value": &schema.Schema{
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						// Configuration of RichText fields, added to the
+						// validations of the field.
+						"rich_text": richTextSchema(),
+						// The ID the field had before. Changing the ID of a
+						// field with previous_id set renames it in place, so
+						// its content in entries is kept.
+						"previous_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 					},
 				},
 			},
+			// Transforms applied to all entries when the migration changes,
+			// after new fields are added and before removed fields are deleted.
+			"migration": migrationSchema(),
+			// The fields renamed by the plan, keyed by their new ID.
+			"renamed_fields": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
 		},
 	}
 }
 
+// resourceContentTypeCustomizeDiff validates the default values and the rich
+// text configuration against the type of their field, and rejects field type
+// changes unless replace_on_type_change is set, so mistakes show up in the
+// plan.
+//
+// Fields with values interpolated from other resources are only known at
+// apply, these fields are left out while the known ones are still checked.
+func resourceContentTypeCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	// definition_json conflicts with field, so there is nothing to check
+	// until it is known.
+	if !d.NewValueKnown("definition_json") {
+		return nil
+	}
+
+	if definition := d.Get("definition_json").(string); definition != "" {
+		if _, err := contentTypeDefinitionFields(definition); err != nil {
+			return fmt.Errorf("Invalid definition_json: %s", err)
+		}
+
+		return nil
+	}
+
+	fieldsKnown := d.NewValueKnown("field")
+	fields := d.Get("field").(*schema.Set)
+
+	if fieldsKnown && fields.Len() == 0 {
+		return fmt.Errorf("One of field or definition_json is required")
+	}
+
+	if !fieldsKnown {
+		fields = knownFields(fields)
+	}
+
+	if d.Id() != "" && d.HasChange("field") {
+		old, _ := d.GetChange("field")
+
+		renames, err := fieldRenames(old.(*schema.Set), fields)
+		if err != nil {
+			return err
+		}
+
+		if !fieldsKnown {
+			// Renames of the unknown fields only show up at apply.
+			if err = d.SetNewComputed("renamed_fields"); err != nil {
+				return err
+			}
+		} else if len(renames) > 0 || len(d.Get("renamed_fields").(map[string]interface{})) > 0 {
+			renamedFields := map[string]interface{}{}
+			for newID, previousID := range renames {
+				renamedFields[newID] = previousID
+			}
+
+			if err = d.SetNew("renamed_fields", renamedFields); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.Id() != "" && d.HasChange("field") && !d.Get("replace_on_type_change").(bool) {
+		old, _ := d.GetChange("field")
+
+		if changed := typeChangedFields(old.(*schema.Set), fields); len(changed) > 0 {
+			return fmt.Errorf(
+				"The type of field(s) %s cannot be changed in Contentful. Set replace_on_type_change = true "+
+					"to delete and recreate them, which deletes their content in all entries, or use a new field ID",
+				strings.Join(changed, ", "),
+			)
+		}
+	}
+
+	for _, rawField := range fields.List() {
+		field := rawField.(map[string]interface{})
+
+		if err := checkLinkContentTypes(field); err != nil {
+			return fmt.Errorf("Invalid field %s: %s", field["id"].(string), err)
+		}
+
+		_, err := expandFieldDefaultValue(field["type"].(string), field["default_value"].(map[string]interface{}))
+		if err != nil {
+			return fmt.Errorf("Invalid default_value of field %s: %s", field["id"].(string), err)
+		}
+
+		if err = checkRichText(field["type"].(string), field["rich_text"].([]interface{})); err != nil {
+			return fmt.Errorf("Invalid rich_text of field %s: %s", field["id"].(string), err)
+		}
+	}
+
+	// Content types of a space created in the same apply, and the ones
+	// interpolated from other resources, are checked at apply.
+	if !d.NewValueKnown("space_id") || !d.HasChange("field") {
+		return nil
+	}
+
+	self := d.Id()
+	if self == "" {
+		self = d.Get("content_type_id").(string)
+	}
+
+	client := m.(*contentful.Contentful)
+
+	return checkContentTypesExist(client, d.Get("space_id").(string), "master", linkedContentTypes(fields, self))
+}
+
 func resourceContentTypeCreate(d *schema.ResourceData, m interface{}) (err error) {
 	client := m.(*contentful.Contentful)
 	spaceID := d.Get("space_id").(string)
@@ -125,6 +312,58 @@ func resourceContentTypeCreate(d *schema.ResourceData, m interface{}) (err error
 		ct.Description = description.(string)
 	}
 
+	if contentTypeID, ok := d.GetOk("content_type_id"); ok {
+		ct.Sys = &contentful.Sys{ID: contentTypeID.(string)}
+	}
+
+	linked := linkedContentTypes(d.Get("field").(*schema.Set), d.Get("content_type_id").(string))
+	if err = waitForContentTypes(client, spaceID, "master", linked); err != nil {
+		return err
+	}
+
+	if definition, ok := d.GetOk("definition_json"); ok {
+		fields, err := contentTypeDefinitionFields(definition.(string))
+		if err != nil {
+			return err
+		}
+
+		err = saveContentTypeDefinition(client, spaceID, ct, fields)
+		if err != nil {
+			return err
+		}
+	} else {
+		fields, extras, err := buildContentTypeFields(d)
+		if err != nil {
+			return err
+		}
+
+		ct.Fields = fields
+
+		if err = saveContentType(client, spaceID, ct, extras); err != nil {
+			return err
+		}
+	}
+
+	if err = client.ContentTypes.Activate(spaceID, ct); err != nil {
+		//@TODO Maybe delete the CT ?
+		return err
+	}
+
+	if err = setContentTypeProperties(d, ct); err != nil {
+		return err
+	}
+
+	d.SetId(ct.Sys.ID)
+
+	return nil
+}
+
+// buildContentTypeFields builds the fields of the content type from the field
+// blocks.
+func buildContentTypeFields(d *schema.ResourceData) ([]*contentful.Field, contentTypeFieldExtras, error) {
+	fields := []*contentful.Field{}
+	extras := contentTypeFieldExtras{}
+
 	for _, rawField := range d.Get("field").(*schema.Set).List() {
 		field := rawField.(map[string]interface{})
 
@@ -145,7 +384,7 @@ func resourceContentTypeCreate(d *schema.ResourceData, m interface{}) (err error
 		if validations, ok := field["validations"].([]interface{}); ok {
 			parsedValidations, err := contentful.ParseValidations(validations)
 			if err != nil {
-				return err
+				return nil, nil, err
 			}
 
 			contentfulField.Validations = parsedValidations
@@ -155,43 +394,65 @@ func resourceContentTypeCreate(d *schema.ResourceData, m interface{}) (err error
 			contentfulField.Items = items
 		}
 
-		ct.Fields = append(ct.Fields, contentfulField)
+		fieldExtras, err := expandFieldExtras(field)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		extras[contentfulField.ID] = fieldExtras
+		fields = append(fields, contentfulField)
 	}
 
-	if err = client.ContentTypes.Upsert(spaceID, ct); err != nil {
-		return err
+	return fields, extras, nil
+}
+
+func resourceContentTypeRead(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+
+	doc, err := getContentTypeDocument(client, spaceID, d.Id())
+	if isNotFound(err) {
+		d.SetId("")
+		return nil
 	}
 
-	if err = client.ContentTypes.Activate(spaceID, ct); err != nil {
-		//@TODO Maybe delete the CT ?
+	if err != nil {
 		return err
 	}
 
-	if err = setContentTypeProperties(d, ct); err != nil {
+	if err = d.Set("version", doc.Sys.Version); err != nil {
 		return err
 	}
 
-	d.SetId(ct.Sys.ID)
-
-	return nil
-}
-
-func resourceContentTypeRead(d *schema.ResourceData, m interface{}) (err error) {
-	client := m.(*contentful.Contentful)
-	spaceID := d.Get("space_id").(string)
+	if _, ok := d.GetOk("definition_json"); ok {
+		definition, err := contentTypeDocumentDefinition(doc)
+		if err != nil {
+			return err
+		}
 
-	_, err = client.ContentTypes.Get(spaceID, d.Id())
+		return d.Set("definition_json", definition)
+	}
 
-	return err
+	return refreshFieldExtras(d, doc)
 }
 
 func resourceContentTypeUpdate(d *schema.ResourceData, m interface{}) (err error) {
 	var existingFields []*contentful.Field
 	var deletedFields []*contentful.Field
+	var replacedFields []string
+	var renames map[string]string
+	var extras contentTypeFieldExtras
 
 	client := m.(*contentful.Contentful)
 	spaceID := d.Get("space_id").(string)
 
+	if d.HasChange("field") {
+		linked := linkedContentTypes(d.Get("field").(*schema.Set), d.Id())
+		if err = waitForContentTypes(client, spaceID, "master", linked); err != nil {
+			return err
+		}
+	}
+
 	ct, err := client.ContentTypes.Get(spaceID, d.Id())
 	if err != nil {
 		return err
@@ -204,22 +465,95 @@ func resourceContentTypeUpdate(d *schema.ResourceData, m interface{}) (err error
 		ct.Description = description.(string)
 	}
 
+	migrate := func() error {
+		if !d.HasChange("migration") {
+			return nil
+		}
+
+		err := migrateEntries(client, spaceID, d.Id(), d.Get("migration").([]interface{}))
+		if err != nil {
+			// Keep the previous migration in the state, so it runs again.
+			old, _ := d.GetChange("migration")
+			if setErr := d.Set("migration", old); setErr != nil {
+				return setErr
+			}
+		}
+
+		return err
+	}
+
+	if definition, ok := d.GetOk("definition_json"); ok {
+		if err = updateContentTypeDefinition(client, spaceID, ct, definition.(string), migrate); err != nil {
+			return err
+		}
+
+		return setContentTypeProperties(d, ct)
+	}
+
 	if d.HasChange("field") {
 		old, new := d.GetChange("field")
 
-		existingFields, deletedFields = checkFieldChanges(old.(*schema.Set), new.(*schema.Set))
+		renames, err = fieldRenames(old.(*schema.Set), new.(*schema.Set))
+		if err != nil {
+			return err
+		}
 
-		ct.Fields = existingFields
+		existingFields, deletedFields, extras = checkFieldChanges(old.(*schema.Set), new.(*schema.Set))
+		replacedFields = typeChangedFields(old.(*schema.Set), new.(*schema.Set))
+
+		if len(replacedFields) > 0 && !d.Get("replace_on_type_change").(bool) {
+			return fmt.Errorf("The type of field(s) %s cannot be changed", strings.Join(replacedFields, ", "))
+		}
+
+		ct.Fields = withoutFields(existingFields, replacedFields)
 
 		if deletedFields != nil {
 			ct.Fields = append(ct.Fields, deletedFields...)
 		}
+	} else {
+		extras = contentTypeFieldExtras{}
+
+		for _, rawField := range d.Get("field").(*schema.Set).List() {
+			field := rawField.(map[string]interface{})
+
+			fieldExtras, err := expandFieldExtras(field)
+			if err != nil {
+				return err
+			}
+
+			extras[field["id"].(string)] = fieldExtras
+		}
+	}
+
+	doc, err := getContentTypeDocument(client, spaceID, d.Id())
+	if err != nil {
+		return err
+	}
+
+	addFieldIDExtras(extras, contentTypeFieldIDs(doc, renames))
+
+	// The extras of replaced fields belong to their new type, so they are only
+	// sent once the field is recreated.
+	replacedExtras := contentTypeFieldExtras{}
+	for id, fieldExtras := range extras {
+		if !containsString(replacedFields, id) {
+			replacedExtras[id] = fieldExtras
+			continue
+		}
+
+		replacedExtras[id] = map[string]interface{}{}
+		for _, property := range []string{"id", "apiName"} {
+			if value, ok := fieldExtras[property]; ok {
+				replacedExtras[id][property] = value
+			}
+		}
 	}
 
 	// To remove a field from a content type 4 API calls need to be made.
 	// Ommit the removed fields and publish the new version of the content type,
-	// followed by the field removal and final publish.
-	if err = client.ContentTypes.Upsert(spaceID, ct); err != nil {
+	// followed by the field removal and final publish. Fields with a new type
+	// are removed the same way and recreated with 2 more calls.
+	if err = saveContentType(client, spaceID, ct, replacedExtras); err != nil {
 		return err
 	}
 
@@ -228,10 +562,15 @@ func resourceContentTypeUpdate(d *schema.ResourceData, m interface{}) (err error
 		return err
 	}
 
+	// Entries are migrated while the content of removed fields is still there.
+	if err = migrate(); err != nil {
+		return err
+	}
+
 	if deletedFields != nil {
-		ct.Fields = existingFields
+		ct.Fields = withoutFields(existingFields, replacedFields)
 
-		if err = client.ContentTypes.Upsert(spaceID, ct); err != nil {
+		if err = saveContentType(client, spaceID, ct, replacedExtras); err != nil {
 			return err
 		}
 
@@ -241,18 +580,112 @@ func resourceContentTypeUpdate(d *schema.ResourceData, m interface{}) (err error
 		}
 	}
 
+	if len(replacedFields) > 0 {
+		ct.Fields = existingFields
+
+		if err = saveContentType(client, spaceID, ct, extras); err != nil {
+			return err
+		}
+
+		if err = client.ContentTypes.Activate(spaceID, ct); err != nil {
+			return fmt.Errorf("Recreating field(s) %s with their new type: %s", strings.Join(replacedFields, ", "), err)
+		}
+	}
+
+	// The renames are applied, they only show up in the plan.
+	if err = d.Set("renamed_fields", map[string]interface{}{}); err != nil {
+		return err
+	}
+
 	return setContentTypeProperties(d, ct)
 }
 
+// updateContentTypeDefinition updates the content type to the fields of the
+// definition. Like for field blocks, removed fields are omitted first and
+// deleted with a second update, with the entries migrated in between.
+func updateContentTypeDefinition(client *contentful.Contentful, spaceID string, ct *contentful.ContentType, definition string, migrate func() error) error {
+	fields, err := contentTypeDefinitionFields(definition)
+	if err != nil {
+		return err
+	}
+
+	current, err := getContentTypeDocument(client, spaceID, ct.Sys.ID)
+	if err != nil {
+		return err
+	}
+
+	defined := map[string]bool{}
+	for _, rawField := range fields {
+		field := rawField.(map[string]interface{})
+		defined[field["id"].(string)] = true
+	}
+
+	var omittedFields []interface{}
+	for _, field := range current.Fields {
+		if id, _ := field["id"].(string); defined[id] {
+			continue
+		}
+
+		if apiName, ok := field["apiName"].(string); ok && defined[apiName] {
+			continue
+		}
+
+		field["omitted"] = true
+		omittedFields = append(omittedFields, field)
+	}
+
+	if omittedFields == nil {
+		if err = saveContentTypeDefinition(client, spaceID, ct, fields); err != nil {
+			return err
+		}
+
+		if err = client.ContentTypes.Activate(spaceID, ct); err != nil {
+			return err
+		}
+
+		return migrate()
+	}
+
+	if err = saveContentTypeDefinition(client, spaceID, ct, append(fields, omittedFields...)); err != nil {
+		return err
+	}
+
+	if err = client.ContentTypes.Activate(spaceID, ct); err != nil {
+		return err
+	}
+
+	if err = migrate(); err != nil {
+		return err
+	}
+
+	if err = saveContentTypeDefinition(client, spaceID, ct, fields); err != nil {
+		return err
+	}
+
+	return client.ContentTypes.Activate(spaceID, ct)
+}
+
 func resourceContentTypeDelete(d *schema.ResourceData, m interface{}) (err error) {
 	client := m.(*contentful.Contentful)
 	spaceID := d.Get("space_id").(string)
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf(
+			"Content type %s has deletion_protection enabled. Set deletion_protection = false and apply before destroying it",
+			d.Id(),
+		)
+	}
+
 	ct, err := client.ContentTypes.Get(spaceID, d.Id())
 	if err != nil {
 		return err
 	}
 
+	err = removeContentTypeEntries(client, spaceID, ct.Sys.ID, d.Get("delete_entries_on_destroy").(bool))
+	if err != nil {
+		return err
+	}
+
 	err = client.ContentTypes.Deactivate(spaceID, ct)
 	if err != nil {
 		return err
@@ -265,28 +698,80 @@ func resourceContentTypeDelete(d *schema.ResourceData, m interface{}) (err error
 	return nil
 }
 
+// removeContentTypeEntries makes sure no entries of the content type are left
+// before it is deleted, as Contentful refuses to delete it otherwise. Entries
+// are only deleted when deleteEntries is set.
+func removeContentTypeEntries(client *contentful.Contentful, spaceID, contentTypeID string, deleteEntries bool) error {
+	query := url.Values{}
+	query.Set("content_type", contentTypeID)
+
+	count, err := countEntries(client, spaceID, "", query)
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return nil
+	}
+
+	if !deleteEntries {
+		return fmt.Errorf(
+			"Content type %s still has %d entries. Delete them first or set delete_entries_on_destroy = true",
+			contentTypeID, count,
+		)
+	}
+
+	// Collect all entries up front, deleting while paging would skip entries.
+	query.Set("order", "sys.createdAt")
+
+	entries, err := listEntries(client, spaceID, "", query, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		if err = deleteEntry(client, spaceID, "", e); err != nil {
+			return fmt.Errorf("Deleting entry %s of content type %s: %s", e.Sys.ID, contentTypeID, err)
+		}
+	}
+
+	return nil
+}
+
 func setContentTypeProperties(d *schema.ResourceData, ct *contentful.ContentType) (err error) {
 
 	if err = d.Set("version", ct.Sys.Version); err != nil {
 		return err
 	}
 
+	if err = d.Set("content_type_id", ct.Sys.ID); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func checkFieldChanges(old, new *schema.Set) ([]*contentful.Field, []*contentful.Field) {
+func checkFieldChanges(old, new *schema.Set) ([]*contentful.Field, []*contentful.Field, contentTypeFieldExtras) {
 	var contentfulField *contentful.Field
 	var existingFields []*contentful.Field
 	var deletedFields []*contentful.Field
 	var fieldRemoved bool
 
+	extras := contentTypeFieldExtras{}
+
+	// Renames were validated in the plan.
+	renames, _ := fieldRenames(old, new)
+
 	for _, f := range old.List() {
 		oldField := f.(map[string]interface{})
 
+		// Fields with a new type are removed as well, to be recreated.
 		fieldRemoved = true
 		for _, newField := range new.List() {
-			if oldField["id"].(string) == newField.(map[string]interface{})["id"].(string) {
-				fieldRemoved = false
+			newID := newField.(map[string]interface{})["id"].(string)
+
+			if oldField["id"].(string) == newID || oldField["id"].(string) == renames[newID] {
+				fieldRemoved = fieldTypeChanged(oldField, newField.(map[string]interface{}))
 				break
 			}
 		}
@@ -301,6 +786,7 @@ func checkFieldChanges(old, new *schema.Set) ([]*contentful.Field, []*contentful
 					Localized: oldField["localized"].(bool),
 					Required:  oldField["required"].(bool),
 					Disabled:  oldField["disabled"].(bool),
+					Items:     processItems(oldField["items"].(*schema.Set)),
 					Omitted:   true,
 				})
 		}
@@ -333,10 +819,245 @@ func checkFieldChanges(old, new *schema.Set) ([]*contentful.Field, []*contentful
 			contentfulField.Items = items
 		}
 
+		extras[contentfulField.ID], _ = expandFieldExtras(newField)
+
 		existingFields = append(existingFields, contentfulField)
 	}
 
-	return existingFields, deletedFields
+	return existingFields, deletedFields, extras
+}
+
+// fieldTypeChanged reports whether the type of the field changed, including
+// the type of links and array items.
+func fieldTypeChanged(oldField, newField map[string]interface{}) bool {
+	if oldField["type"].(string) != newField["type"].(string) ||
+		oldField["link_type"].(string) != newField["link_type"].(string) {
+		return true
+	}
+
+	oldItems := processItems(oldField["items"].(*schema.Set))
+	newItems := processItems(newField["items"].(*schema.Set))
+
+	if oldItems == nil || newItems == nil {
+		return oldItems != newItems
+	}
+
+	return oldItems.Type != newItems.Type || oldItems.LinkType != newItems.LinkType
+}
+
+// knownFields returns the fields whose values are all known. Unknown values
+// of a planned set read as empty strings, which are not valid for any of the
+// values the plan checks.
+func knownFields(fields *schema.Set) *schema.Set {
+	known := schema.NewSet(fields.F, nil)
+
+	for _, rawField := range fields.List() {
+		if fieldValuesKnown(rawField.(map[string]interface{})) {
+			known.Add(rawField)
+		}
+	}
+
+	return known
+}
+
+func fieldValuesKnown(field map[string]interface{}) bool {
+	if field["id"].(string) == "" || field["type"].(string) == "" {
+		return false
+	}
+
+	if field["type"].(string) == "Link" && field["link_type"].(string) == "" {
+		return false
+	}
+
+	for _, rawItems := range field["items"].(*schema.Set).List() {
+		items := rawItems.(map[string]interface{})
+
+		if items["type"].(string) == "" || (items["type"].(string) == "Link" && items["link_type"].(string) == "") {
+			return false
+		}
+
+		if containsString(interfaceStrings(items["link_content_types"].([]interface{})), "") {
+			return false
+		}
+	}
+
+	if containsString(interfaceStrings(field["link_content_types"].([]interface{})), "") {
+		return false
+	}
+
+	for _, value := range field["default_value"].(map[string]interface{}) {
+		if value.(string) == "" {
+			return false
+		}
+	}
+
+	for _, rawRichText := range field["rich_text"].([]interface{}) {
+		if rawRichText == nil {
+			continue
+		}
+
+		richText := rawRichText.(map[string]interface{})
+
+		if containsString(interfaceStrings(richText["enabled_node_types"].([]interface{})), "") ||
+			containsString(interfaceStrings(richText["enabled_marks"].([]interface{})), "") {
+			return false
+		}
+
+		for _, rawNode := range richText["node"].(*schema.Set).List() {
+			node := rawNode.(map[string]interface{})
+
+			if node["type"].(string) == "" || containsString(interfaceStrings(node["link_content_types"].([]interface{})), "") {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func interfaceStrings(values []interface{}) []string {
+	var s []string
+	for _, value := range values {
+		s = append(s, value.(string))
+	}
+
+	return s
+}
+
+// typeChangedFields returns the sorted IDs of the fields whose type changed,
+// including renamed fields.
+func typeChangedFields(old, new *schema.Set) []string {
+	oldFields := map[string]map[string]interface{}{}
+	for _, f := range old.List() {
+		oldField := f.(map[string]interface{})
+		oldFields[oldField["id"].(string)] = oldField
+	}
+
+	renames, _ := fieldRenames(old, new)
+
+	var changed []string
+	for _, f := range new.List() {
+		newField := f.(map[string]interface{})
+		id := newField["id"].(string)
+
+		oldField, ok := oldFields[id]
+		if !ok {
+			oldField, ok = oldFields[renames[id]]
+		}
+
+		if ok && fieldTypeChanged(oldField, newField) {
+			changed = append(changed, id)
+		}
+	}
+
+	sort.Strings(changed)
+
+	return changed
+}
+
+// fieldRenames returns the previous IDs of the renamed fields, keyed by their
+// new ID. A field is renamed when its previous_id is in the old fields and its
+// ID is not.
+func fieldRenames(old, new *schema.Set) (map[string]string, error) {
+	oldIDs := map[string]bool{}
+	for _, f := range old.List() {
+		oldIDs[f.(map[string]interface{})["id"].(string)] = true
+	}
+
+	newIDs := map[string]bool{}
+	for _, f := range new.List() {
+		newIDs[f.(map[string]interface{})["id"].(string)] = true
+	}
+
+	renames := map[string]string{}
+	renamed := map[string]string{}
+
+	for _, f := range new.List() {
+		newField := f.(map[string]interface{})
+		id := newField["id"].(string)
+		previousID, _ := newField["previous_id"].(string)
+
+		if previousID == "" || previousID == id || oldIDs[id] || !oldIDs[previousID] {
+			continue
+		}
+
+		if newIDs[previousID] {
+			return nil, fmt.Errorf("Field %s cannot be renamed to %s, a field with ID %s is still defined", previousID, id, previousID)
+		}
+
+		if other, ok := renamed[previousID]; ok {
+			return nil, fmt.Errorf("Field %s cannot be renamed to both %s and %s", previousID, other, id)
+		}
+
+		renames[id] = previousID
+		renamed[previousID] = id
+	}
+
+	return renames, nil
+}
+
+// contentTypeFieldIDs maps the IDs of the fields, as used in the
+// configuration, to their internal IDs in Contentful. They differ once a
+// field is renamed, as Contentful only changes its API name.
+func contentTypeFieldIDs(doc *contentTypeDocument, renames map[string]string) map[string]string {
+	ids := map[string]string{}
+
+	for _, field := range doc.Fields {
+		id, _ := field["id"].(string)
+
+		apiName, _ := field["apiName"].(string)
+		if apiName == "" {
+			apiName = id
+		}
+
+		ids[apiName] = id
+	}
+
+	for newID, previousID := range renames {
+		if internalID, ok := ids[previousID]; ok {
+			ids[newID] = internalID
+		}
+	}
+
+	return ids
+}
+
+// addFieldIDExtras makes the fields whose ID differs from their internal ID
+// keep the internal ID and use their ID as API name. The extras are also
+// keyed by internal ID, for fields taken as is from the current content type.
+func addFieldIDExtras(extras contentTypeFieldExtras, ids map[string]string) {
+	for id, internalID := range ids {
+		if id == internalID {
+			continue
+		}
+
+		fieldExtras := map[string]interface{}{}
+		for property, value := range extras[id] {
+			fieldExtras[property] = value
+		}
+
+		fieldExtras["id"] = internalID
+		fieldExtras["apiName"] = id
+
+		extras[id] = fieldExtras
+
+		if _, ok := extras[internalID]; !ok {
+			extras[internalID] = fieldExtras
+		}
+	}
+}
+
+// withoutFields returns the fields except the ones with the given IDs.
+func withoutFields(fields []*contentful.Field, ids []string) []*contentful.Field {
+	var remaining []*contentful.Field
+
+	for _, field := range fields {
+		if !containsString(ids, field.ID) {
+			remaining = append(remaining, field)
+		}
+	}
+
+	return remaining
 }
 
 func processItems(fieldItems *schema.Set) *contentful.FieldTypeArrayItem {
@@ -359,3 +1080,162 @@ func processItems(fieldItems *schema.Set) *contentful.FieldTypeArrayItem {
 	}
 	return items
 }
+
+// expandFieldExtras returns the properties of the field that the SDK does not
+// support.
+func expandFieldExtras(field map[string]interface{}) (map[string]interface{}, error) {
+	extras := map[string]interface{}{}
+
+	defaultValue, err := expandFieldDefaultValue(field["type"].(string), field["default_value"].(map[string]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	if defaultValue != nil {
+		extras["defaultValue"] = defaultValue
+	}
+
+	validations := expandRichTextValidations(field["rich_text"].([]interface{}))
+	validations = append(validations, expandLinkContentTypes(field["link_content_types"].([]interface{}))...)
+
+	if len(validations) > 0 {
+		extras["validations"] = validations
+	}
+
+	for _, rawItems := range field["items"].(*schema.Set).List() {
+		items := rawItems.(map[string]interface{})
+
+		if itemsValidations := expandLinkContentTypes(items["link_content_types"].([]interface{})); len(itemsValidations) > 0 {
+			extras["items.validations"] = itemsValidations
+		}
+	}
+
+	return extras, nil
+}
+
+// defaultValueDateLayouts are the accepted formats of Date default values.
+var defaultValueDateLayouts = []string{"2006-01-02", "2006-01-02T15:04", time.RFC3339}
+
+// expandFieldDefaultValue converts the default values, given as strings keyed
+// by locale, into values of the type of the field.
+func expandFieldDefaultValue(fieldType string, raw map[string]interface{}) (map[string]interface{}, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	defaultValue := map[string]interface{}{}
+
+	for locale, rawValue := range raw {
+		value := rawValue.(string)
+
+		var err error
+
+		// Values are read back the way the API formats them, so only that
+		// form is accepted to avoid a permanent diff.
+		canonical := value
+
+		switch fieldType {
+		case "Symbol", "Text":
+			defaultValue[locale] = value
+		case "Boolean":
+			var b bool
+			b, err = strconv.ParseBool(value)
+			defaultValue[locale], canonical = b, strconv.FormatBool(b)
+		case "Integer":
+			var i int64
+			i, err = strconv.ParseInt(value, 10, 64)
+			defaultValue[locale], canonical = i, strconv.FormatInt(i, 10)
+		case "Number":
+			var f float64
+			f, err = strconv.ParseFloat(value, 64)
+			defaultValue[locale], canonical = f, strconv.FormatFloat(f, 'f', -1, 64)
+		case "Date":
+			err = fmt.Errorf("%q is not a date", value)
+			for _, layout := range defaultValueDateLayouts {
+				if _, parseErr := time.Parse(layout, value); parseErr == nil {
+					err = nil
+					break
+				}
+			}
+			defaultValue[locale] = value
+		default:
+			return nil, fmt.Errorf("%s fields do not support default values", fieldType)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value for locale %s: %s", fieldType, locale, err)
+		}
+
+		if canonical != value {
+			return nil, fmt.Errorf("%s value %q for locale %s must be written as %q", fieldType, value, locale, canonical)
+		}
+	}
+
+	return defaultValue, nil
+}
+
+// flattenFieldDefaultValue converts default values returned by the API into
+// strings keyed by locale.
+func flattenFieldDefaultValue(raw interface{}) map[string]interface{} {
+	defaultValue := map[string]interface{}{}
+
+	values, ok := raw.(map[string]interface{})
+	if !ok {
+		return defaultValue
+	}
+
+	for locale, value := range values {
+		switch v := value.(type) {
+		case string:
+			defaultValue[locale] = v
+		case bool:
+			defaultValue[locale] = strconv.FormatBool(v)
+		case float64:
+			defaultValue[locale] = strconv.FormatFloat(v, 'f', -1, 64)
+		}
+	}
+
+	return defaultValue
+}
+
+// refreshFieldExtras updates the properties the SDK does not support on the
+// fields in the state from the content type returned by the API.
+func refreshFieldExtras(d *schema.ResourceData, doc *contentTypeDocument) error {
+	var fields []interface{}
+
+	for _, rawField := range d.Get("field").(*schema.Set).List() {
+		field := rawField.(map[string]interface{})
+
+		if remote := doc.field(field["id"].(string)); remote != nil {
+			field["default_value"] = flattenFieldDefaultValue(remote["defaultValue"])
+
+			// Rich text and link validations written by hand in validations
+			// stay there.
+			if richText := field["rich_text"].([]interface{}); len(richText) > 0 {
+				field["rich_text"] = flattenRichText(remote["validations"])
+			}
+
+			if len(field["link_content_types"].([]interface{})) > 0 {
+				field["link_content_types"] = flattenLinkContentTypes(remote["validations"])
+			}
+
+			remoteItems, _ := remote["items"].(map[string]interface{})
+
+			var items []interface{}
+			for _, rawItems := range field["items"].(*schema.Set).List() {
+				item := rawItems.(map[string]interface{})
+
+				if len(item["link_content_types"].([]interface{})) > 0 && remoteItems != nil {
+					item["link_content_types"] = flattenLinkContentTypes(remoteItems["validations"])
+				}
+
+				items = append(items, item)
+			}
+			field["items"] = items
+		}
+
+		fields = append(fields, field)
+	}
+
+	return d.Set("field", fields)
+}
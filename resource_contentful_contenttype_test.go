@@ -1,10 +1,17 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	contentful "github.com/tolgaakyuz/contentful-go"
 )
@@ -34,6 +41,686 @@ func TestAccContentfulContentType_Basic(t *testing.T) {
 	})
 }
 
+func TestAccContentfulContentType_DeletionProtection(t *testing.T) {
+	spaceName := fmt.Sprintf("space-name-%s", acctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContentfulContentTypeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulContentTypeDeletionProtectionConfig(spaceName, true),
+				Check: resource.TestCheckResourceAttr(
+					"contentful_contenttype.page", "deletion_protection", "true"),
+			},
+			resource.TestStep{
+				Config:      testAccContentfulContentTypeWithoutContentTypeConfig(spaceName),
+				ExpectError: regexp.MustCompile("has deletion_protection enabled"),
+			},
+			// Once the protection is off, the destroy at the end succeeds.
+			resource.TestStep{
+				Config: testAccContentfulContentTypeDeletionProtectionConfig(spaceName, false),
+				Check: resource.TestCheckResourceAttr(
+					"contentful_contenttype.page", "deletion_protection", "false"),
+			},
+		},
+	})
+}
+
+func TestAccContentfulContentType_DefaultValue(t *testing.T) {
+	spaceName := fmt.Sprintf("space-name-%s", acctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContentfulContentTypeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccContentfulContentTypeDefaultValueConfig(spaceName, "maybe"),
+				ExpectError: regexp.MustCompile("Invalid default_value of field featured"),
+			},
+			resource.TestStep{
+				Config: testAccContentfulContentTypeDefaultValueConfig(spaceName, "true"),
+				Check: resource.TestCheckResourceAttr(
+					"contentful_contenttype.page", "version", "2"),
+			},
+			resource.TestStep{
+				Config: testAccContentfulContentTypeDefaultValueConfig(spaceName, "false"),
+				Check: resource.TestCheckResourceAttr(
+					"contentful_contenttype.page", "field.#", "3"),
+			},
+		},
+	})
+}
+
+func TestExpandFieldDefaultValue(t *testing.T) {
+	defaultValue, err := expandFieldDefaultValue("Integer", map[string]interface{}{"en-US": "10"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if !reflect.DeepEqual(defaultValue, map[string]interface{}{"en-US": int64(10)}) {
+		t.Fatalf("unexpected default value: %#v", defaultValue)
+	}
+
+	if _, err = expandFieldDefaultValue("Boolean", map[string]interface{}{"en-US": "yes"}); err == nil {
+		t.Fatal("expected an error for an invalid Boolean")
+	}
+
+	for fieldType, value := range map[string]string{"Boolean": "True", "Number": "1.50", "Integer": "+5"} {
+		if _, err = expandFieldDefaultValue(fieldType, map[string]interface{}{"en-US": value}); err == nil {
+			t.Fatalf("expected an error for the %s value %q, which is read back differently", fieldType, value)
+		}
+	}
+
+	if _, err = expandFieldDefaultValue("Number", map[string]interface{}{"en-US": "1.5"}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if _, err = expandFieldDefaultValue("Date", map[string]interface{}{"en-US": "2019-01-31"}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if _, err = expandFieldDefaultValue("Location", map[string]interface{}{"en-US": "here"}); err == nil {
+		t.Fatal("expected an error for an unsupported field type")
+	}
+
+	flattened := flattenFieldDefaultValue(map[string]interface{}{"en-US": float64(10), "de": true})
+	if !reflect.DeepEqual(flattened, map[string]interface{}{"en-US": "10", "de": "true"}) {
+		t.Fatalf("unexpected flattened default value: %#v", flattened)
+	}
+}
+
+func TestRemoveContentTypeEntries(t *testing.T) {
+	var requests []string
+
+	client, teardown := testCMAServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		if r.URL.Query().Get("content_type") != "page" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+
+		fmt.Fprint(w, `{
+  "total": 2,
+  "items": [
+    {"sys": {"id": "published", "version": 2, "publishedVersion": 1}},
+    {"sys": {"id": "draft", "version": 1}}
+  ]
+}`)
+	})
+	defer teardown()
+
+	err := removeContentTypeEntries(client, "space", "page", false)
+	if err == nil || !strings.Contains(err.Error(), "Content type page still has 2 entries") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(requests) != 1 {
+		t.Fatalf("expected only the entries to be counted, got %v", requests)
+	}
+
+	requests = nil
+
+	if err = removeContentTypeEntries(client, "space", "page", true); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []string{
+		"GET /spaces/space/entries",
+		"GET /spaces/space/entries",
+		"DELETE /spaces/space/entries/published/published",
+		"DELETE /spaces/space/entries/published",
+		"DELETE /spaces/space/entries/draft",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestAccContentfulContentType_RichText(t *testing.T) {
+	spaceName := fmt.Sprintf("space-name-%s", acctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContentfulContentTypeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccContentfulContentTypeRichTextConfig(spaceName, `"heading-1"`),
+				ExpectError: regexp.MustCompile("node embedded-entry-block is configured but not in enabled_node_types"),
+			},
+			resource.TestStep{
+				Config: testAccContentfulContentTypeRichTextConfig(spaceName, `"heading-1", "embedded-entry-block"`),
+				Check: resource.TestCheckResourceAttr(
+					"contentful_contenttype.article", "name", "Article"),
+			},
+		},
+	})
+}
+
+func TestRichTextValidations(t *testing.T) {
+	node := map[string]interface{}{
+		"type":               "embedded-entry-block",
+		"link_content_types": []interface{}{"author"},
+		"min":                0,
+		"max":                3,
+	}
+
+	richText := []interface{}{
+		map[string]interface{}{
+			"enabled_node_types": []interface{}{"heading-1", "embedded-entry-block"},
+			"enabled_marks":      []interface{}{"bold"},
+			"node":               schema.NewSet(schema.HashResource(richTextSchema().Elem.(*schema.Resource).Schema["node"].Elem.(*schema.Resource)), []interface{}{node}),
+		},
+	}
+
+	if err := checkRichText("Text", richText); err == nil {
+		t.Fatal("expected an error for a Text field")
+	}
+
+	if err := checkRichText("RichText", richText); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Round trip through JSON, like the validations returned by the API.
+	encoded, err := json.Marshal(expandRichTextValidations(richText))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	var validations interface{}
+	if err = json.Unmarshal(encoded, &validations); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"enabled_node_types": []interface{}{"heading-1", "embedded-entry-block"},
+			"enabled_marks":      []interface{}{"bold"},
+			"node":               []interface{}{node},
+		},
+	}
+
+	if flattened := flattenRichText(validations); !reflect.DeepEqual(flattened, expected) {
+		t.Fatalf("unexpected rich text: %#v", flattened)
+	}
+}
+
+func TestAccContentfulContentType_TypeChange(t *testing.T) {
+	spaceName := fmt.Sprintf("space-name-%s", acctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContentfulContentTypeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulContentTypeTypeChangeConfig(spaceName, "Symbol", false),
+			},
+			resource.TestStep{
+				Config:      testAccContentfulContentTypeTypeChangeConfig(spaceName, "Text", false),
+				ExpectError: regexp.MustCompile("replace_on_type_change"),
+			},
+			resource.TestStep{
+				Config: testAccContentfulContentTypeTypeChangeConfig(spaceName, "Text", true),
+				Check: resource.TestCheckResourceAttr(
+					"contentful_contenttype.page", "replace_on_type_change", "true"),
+			},
+		},
+	})
+}
+
+func TestTypeChangedFields(t *testing.T) {
+	fieldResource := resourceContentfulContentType().Schema["field"].Elem.(*schema.Resource)
+	itemsResource := fieldResource.Schema["items"].Elem.(*schema.Resource)
+
+	field := func(id, fieldType, itemsLinkType string) interface{} {
+		items := schema.NewSet(schema.HashResource(itemsResource), nil)
+		if itemsLinkType != "" {
+			items.Add(map[string]interface{}{"type": "Link", "link_type": itemsLinkType, "validations": []interface{}{}})
+		}
+
+		return map[string]interface{}{
+			"id":        id,
+			"name":      id,
+			"type":      fieldType,
+			"link_type": "",
+			"items":     items,
+			"localized": false,
+			"required":  false,
+			"disabled":  false,
+			"omitted":   false,
+		}
+	}
+
+	old := schema.NewSet(schema.HashResource(fieldResource), []interface{}{
+		field("title", "Symbol", ""),
+		field("images", "Array", "Asset"),
+		field("body", "Text", ""),
+		field("removed", "Symbol", ""),
+	})
+
+	new := schema.NewSet(schema.HashResource(fieldResource), []interface{}{
+		field("title", "Text", ""),
+		field("images", "Array", "Entry"),
+		field("body", "Text", ""),
+		field("added", "Symbol", ""),
+	})
+
+	if changed := typeChangedFields(old, new); !reflect.DeepEqual(changed, []string{"images", "title"}) {
+		t.Fatalf("unexpected changed fields: %v", changed)
+	}
+}
+
+func TestKnownFields(t *testing.T) {
+	fieldResource := resourceContentfulContentType().Schema["field"].Elem.(*schema.Resource)
+
+	field := func(id, fieldType, linkType string, linkContentTypes ...interface{}) interface{} {
+		return map[string]interface{}{
+			"id":                 id,
+			"type":               fieldType,
+			"link_type":          linkType,
+			"items":              schema.NewSet(schema.HashString, nil),
+			"link_content_types": append([]interface{}{}, linkContentTypes...),
+			"default_value":      map[string]interface{}{},
+			"rich_text":          []interface{}{},
+		}
+	}
+
+	// Unknown values read as empty strings.
+	fields := schema.NewSet(schema.HashResource(fieldResource), []interface{}{
+		field("title", "Symbol", ""),
+		field("author", "Link", "Entry", "author"),
+		field("", "Symbol", ""),
+		field("related", "Link", ""),
+		field("featured", "Link", "Entry", ""),
+	})
+
+	var ids []string
+	for _, rawField := range knownFields(fields).List() {
+		ids = append(ids, rawField.(map[string]interface{})["id"].(string))
+	}
+
+	if expected := []string{"title", "author"}; !reflect.DeepEqual(ids, expected) {
+		t.Fatalf("expected %v, got %v", expected, ids)
+	}
+}
+
+func TestAccContentfulContentType_Rename(t *testing.T) {
+	spaceName := fmt.Sprintf("space-name-%s", acctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContentfulContentTypeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulContentTypeRenameConfig(spaceName, "url", ""),
+			},
+			resource.TestStep{
+				Config: testAccContentfulContentTypeRenameConfig(spaceName, "slug", "url"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_contenttype.page", "renamed_fields.%", "0"),
+					resource.TestCheckResourceAttr("contentful_entry.home", "field.1.id", "slug"),
+					resource.TestCheckResourceAttr("contentful_entry.home", "field.1.content", `"home"`),
+				),
+			},
+		},
+	})
+}
+
+func TestFieldRenames(t *testing.T) {
+	fieldResource := resourceContentfulContentType().Schema["field"].Elem.(*schema.Resource)
+
+	field := func(id, previousID string) interface{} {
+		return map[string]interface{}{"id": id, "previous_id": previousID}
+	}
+
+	old := schema.NewSet(schema.HashResource(fieldResource), []interface{}{field("title", ""), field("url", "")})
+
+	new := schema.NewSet(schema.HashResource(fieldResource), []interface{}{field("title", ""), field("slug", "url")})
+	renames, err := fieldRenames(old, new)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if !reflect.DeepEqual(renames, map[string]string{"slug": "url"}) {
+		t.Fatalf("unexpected renames: %v", renames)
+	}
+
+	// Once applied, the previous_id left in the configuration is ignored.
+	if renames, _ = fieldRenames(new, new); len(renames) != 0 {
+		t.Fatalf("unexpected renames: %v", renames)
+	}
+
+	new = schema.NewSet(schema.HashResource(fieldResource), []interface{}{field("url", ""), field("slug", "url")})
+	if _, err = fieldRenames(old, new); err == nil {
+		t.Fatal("expected an error when the previous field is still defined")
+	}
+}
+
+func TestAddFieldIDExtras(t *testing.T) {
+	doc := &contentTypeDocument{
+		Fields: []map[string]interface{}{
+			{"id": "title", "apiName": "title"},
+			{"id": "url", "apiName": "link"},
+			{"id": "body"},
+		},
+	}
+
+	ids := contentTypeFieldIDs(doc, map[string]string{"slug": "link"})
+	if !reflect.DeepEqual(ids, map[string]string{"title": "title", "link": "url", "slug": "url", "body": "body"}) {
+		t.Fatalf("unexpected ids: %v", ids)
+	}
+
+	extras := contentTypeFieldExtras{"slug": {"defaultValue": map[string]interface{}{"en-US": "home"}}}
+	addFieldIDExtras(extras, ids)
+
+	expected := map[string]interface{}{
+		"id":           "url",
+		"apiName":      "slug",
+		"defaultValue": map[string]interface{}{"en-US": "home"},
+	}
+
+	if !reflect.DeepEqual(extras["slug"], expected) {
+		t.Fatalf("unexpected extras: %v", extras["slug"])
+	}
+
+	if _, ok := extras["title"]; ok {
+		t.Fatal("unexpected extras for a field that was not renamed")
+	}
+}
+
+func TestAccContentfulContentType_DefinitionJSON(t *testing.T) {
+	spaceName := fmt.Sprintf("space-name-%s", acctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContentfulContentTypeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulContentTypeDefinitionConfig(spaceName, `
+    { "id": "title", "name": "Title", "type": "Symbol", "required": true },
+    { "id": "summary", "name": "Summary", "type": "Text", "localized": false, "validations": [] }`),
+				Check: resource.TestCheckResourceAttr(
+					"contentful_contenttype.page", "version", "2"),
+			},
+			resource.TestStep{
+				Config: testAccContentfulContentTypeDefinitionConfig(spaceName, `
+    { "id": "title", "name": "Title", "type": "Symbol", "required": true }`),
+				Check: resource.TestCheckResourceAttr(
+					"contentful_contenttype.page", "field.#", "0"),
+			},
+		},
+	})
+}
+
+func TestNormalizeContentTypeDefinition(t *testing.T) {
+	exported := `{
+  "sys": {"id": "page"},
+  "name": "Page",
+  "fields": [
+    {"id": "title", "name": "Title", "type": "Symbol", "required": true, "localized": false, "validations": []},
+    {"id": "tags", "name": "Tags", "type": "Array", "items": {"type": "Symbol", "validations": []}}
+  ]
+}`
+
+	remote := `{"fields": [
+  {"type": "Symbol", "apiName": "title", "id": "title", "name": "Title", "required": true, "disabled": false, "omitted": false},
+  {"id": "tags", "name": "Tags", "type": "Array", "items": {"type": "Symbol"}}
+]}`
+
+	if !suppressContentTypeDefinitionDiff("definition_json", remote, exported, nil) {
+		t.Fatal("expected the definitions to be equal")
+	}
+
+	if suppressContentTypeDefinitionDiff("definition_json", remote, `{"fields": [{"id": "title", "name": "Title", "type": "Text"}]}`, nil) {
+		t.Fatal("expected the definitions to differ")
+	}
+
+	if _, err := normalizeContentTypeDefinition(`{"fields": [{"id": "title", "type": "Symbol"}]}`); err == nil {
+		t.Fatal("expected an error for a field without name")
+	}
+}
+
+func TestAccContentfulContentType_Migration(t *testing.T) {
+	spaceName := fmt.Sprintf("space-name-%s", acctest.RandString(3))
+
+	var spaceID, contentTypeID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContentfulContentTypeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulContentTypeMigrationConfig(spaceName, ""),
+				Check:  testAccContentfulContentTypeIDs("contentful_contenttype.page", &spaceID, &contentTypeID),
+			},
+			resource.TestStep{
+				PreConfig: func() {
+					testAccCreateContentfulEntry(t, spaceID, contentTypeID, "home", map[string]map[string]interface{}{
+						"title": {"en-US": "Home"},
+						"tags":  {"en-US": "news, sports"},
+					})
+				},
+				Config: testAccContentfulContentTypeMigrationConfig(spaceName, `
+  field {
+    id = "keywords"
+    name = "Keywords"
+    type = "Symbol"
+  }
+
+  migration {
+    copy_field {
+      from = "tags"
+      to = "keywords"
+    }
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"contentful_contenttype.page", "migration.0.copy_field.0.to", "keywords"),
+					testAccCheckContentfulEntryField("contentful_contenttype.page", "home", "keywords", "en-US", "news, sports"),
+				),
+			},
+		},
+	})
+}
+
+func TestApplyMigration(t *testing.T) {
+	e := &entry{
+		Fields: map[string]map[string]interface{}{
+			"summary": {"en-US": "Short", "de": "Kurz"},
+			"teaser":  {"de": "Anriss"},
+			"tags":    {"en-US": "news, sports,,"},
+			"status":  {"en-US": "live"},
+		},
+	}
+
+	migration := map[string]interface{}{
+		"copy_field": []interface{}{
+			map[string]interface{}{"from": "summary", "to": "teaser", "overwrite": false},
+		},
+		"split_string": []interface{}{
+			map[string]interface{}{"from": "tags", "to": "keywords", "separator": ","},
+		},
+		"map_value": []interface{}{
+			map[string]interface{}{"field": "status", "mapping": map[string]interface{}{"live": "published"}},
+		},
+		"set_default": []interface{}{
+			map[string]interface{}{"field": "priority", "locale": "en-US", "value": "10"},
+		},
+	}
+
+	changed, err := applyMigration(e, migration)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if !changed {
+		t.Fatal("expected the entry to change")
+	}
+
+	expected := map[string]map[string]interface{}{
+		"summary":  {"en-US": "Short", "de": "Kurz"},
+		"teaser":   {"en-US": "Short", "de": "Anriss"},
+		"tags":     {"en-US": "news, sports,,"},
+		"keywords": {"en-US": []interface{}{"news", "sports"}},
+		"status":   {"en-US": "published"},
+		"priority": {"en-US": float64(10)},
+	}
+
+	if !reflect.DeepEqual(e.Fields, expected) {
+		t.Fatalf("unexpected fields: %#v", e.Fields)
+	}
+
+	// Applying the migration again changes nothing.
+	if changed, _ = applyMigration(e, migration); changed {
+		t.Fatal("expected the migration to be idempotent")
+	}
+
+	e.Fields["tags"]["en-US"] = []interface{}{"news"}
+	if _, err = applyMigration(e, migration); err == nil {
+		t.Fatal("expected an error when splitting a list")
+	}
+}
+
+func TestAccContentfulContentType_LinkContentTypes(t *testing.T) {
+	spaceName := fmt.Sprintf("space-name-%s", acctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContentfulContentTypeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulContentTypeLinkContentTypesConfig(spaceName, "[]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_contenttype.article", "content_type_id", "article"),
+					resource.TestCheckResourceAttr("contentful_contenttype.author", "content_type_id", "author"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccContentfulContentTypeLinkContentTypesConfig(spaceName, `["author"]`),
+			},
+			resource.TestStep{
+				Config:      testAccContentfulContentTypeLinkContentTypesConfig(spaceName, `["writer"]`),
+				ExpectError: regexp.MustCompile("Content type\\(s\\) writer referenced by link_content_types do not exist"),
+			},
+		},
+	})
+}
+
+func TestLinkContentTypes(t *testing.T) {
+	field := map[string]interface{}{"id": "related", "items": map[string]interface{}{"type": "Link"}}
+
+	mergeFieldProperty(field, "items.validations", expandLinkContentTypes([]interface{}{"article"}))
+	mergeFieldProperty(field, "items.validations", []interface{}{map[string]interface{}{"size": 3}})
+
+	items := field["items"].(map[string]interface{})
+	if validations := items["validations"].([]interface{}); len(validations) != 2 {
+		t.Fatalf("unexpected validations: %#v", validations)
+	}
+
+	contentTypes := flattenLinkContentTypes(items["validations"])
+	if !reflect.DeepEqual(contentTypes, []interface{}{"article"}) {
+		t.Fatalf("unexpected content types: %#v", contentTypes)
+	}
+
+	client, teardown := testCMAServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/spaces/space/environments/staging/content_types" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+
+		fmt.Fprint(w, `{"total": 2, "items": [{"sys": {"id": "article"}}, {"sys": {"id": "author"}}]}`)
+	})
+	defer teardown()
+
+	if err := checkContentTypesExist(client, "space", "staging", []string{"article", "author"}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	err := checkContentTypesExist(client, "space", "staging", []string{"article", "writer", "editor"})
+	if err == nil || !strings.Contains(err.Error(), "editor, writer") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	richTextField := map[string]interface{}{
+		"link_content_types": []interface{}{},
+		"items":              schema.NewSet(schema.HashString, nil),
+		"rich_text": []interface{}{
+			map[string]interface{}{
+				"node": schema.NewSet(schema.HashString, []interface{}{
+					map[string]interface{}{"type": "embedded-entry-block", "link_content_types": []interface{}{"author"}},
+				}),
+			},
+		},
+	}
+
+	if contentTypes := fieldLinkContentTypes(richTextField); !reflect.DeepEqual(contentTypes, []string{"author"}) {
+		t.Fatalf("unexpected content types of rich text nodes: %#v", contentTypes)
+	}
+}
+
+// testAccContentfulContentTypeIDs records the IDs of the space and of the
+// content type from the state.
+func testAccContentfulContentTypeIDs(contentTypeName string, spaceID, contentTypeID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[contentTypeName]
+		if !ok {
+			return fmt.Errorf("Not found %s", contentTypeName)
+		}
+
+		*spaceID = rs.Primary.Attributes["space_id"]
+		*contentTypeID = rs.Primary.ID
+
+		return nil
+	}
+}
+
+// testAccCreateContentfulEntry creates an entry of the content type through
+// the API. Entries managed by contentful_entry would revert migrations.
+func testAccCreateContentfulEntry(t *testing.T, spaceID, contentTypeID, entryID string, fields map[string]map[string]interface{}) {
+	client := testAccProvider.Meta().(*contentful.Contentful)
+	e := &entry{Sys: &entitySys{ID: entryID}, Fields: fields}
+
+	if err := saveEntry(client, spaceID, "", contentTypeID, e); err != nil {
+		t.Fatalf("Creating entry %s: %s", entryID, err)
+	}
+}
+
+// testAccCheckContentfulEntryField checks the value of an entry field in
+// Contentful.
+func testAccCheckContentfulEntryField(contentTypeName, entryID, fieldID, locale string, expected interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[contentTypeName]
+		if !ok {
+			return fmt.Errorf("Not found %s", contentTypeName)
+		}
+
+		client := testAccProvider.Meta().(*contentful.Contentful)
+
+		e := &entry{}
+		if err := cmaGet(client, entryPath(rs.Primary.Attributes["space_id"], "", entryID), nil, e); err != nil {
+			return err
+		}
+
+		if actual := e.Fields[fieldID][locale]; !reflect.DeepEqual(actual, expected) {
+			return fmt.Errorf("Expected %s of entry %s in %s to be %#v, got %#v", fieldID, entryID, locale, expected, actual)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckContentfulContentTypeExists(n string, contentType *contentful.ContentType) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -191,3 +878,328 @@ resource "contentful_contenttype" "mylinked_contenttype" {
 }
 
 `
+
+func testAccContentfulContentTypeDefaultValueConfig(spaceName, featured string) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  default_locale = "en-US"
+  deletion_protection = false
+}
+
+resource "contentful_contenttype" "page" {
+  space_id = "${contentful_space.myspace.id}"
+
+  name = "Page"
+  display_field = "title"
+
+  field {
+    id = "title"
+    name = "Title"
+    type = "Symbol"
+    default_value = {
+      en-US = "Untitled"
+    }
+  }
+
+  field {
+    id = "featured"
+    name = "Featured"
+    type = "Boolean"
+    default_value = {
+      en-US = "%s"
+    }
+  }
+
+  field {
+    id = "priority"
+    name = "Priority"
+    type = "Integer"
+    default_value = {
+      en-US = "10"
+    }
+  }
+}
+`, spaceName, featured)
+}
+
+func testAccContentfulContentTypeRichTextConfig(spaceName, nodeTypes string) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  default_locale = "en-US"
+  deletion_protection = false
+}
+
+resource "contentful_contenttype" "author" {
+  space_id = "${contentful_space.myspace.id}"
+
+  name = "Author"
+  display_field = "name"
+
+  field {
+    id = "name"
+    name = "Name"
+    type = "Symbol"
+  }
+}
+
+resource "contentful_contenttype" "article" {
+  space_id = "${contentful_space.myspace.id}"
+
+  name = "Article"
+  display_field = "title"
+
+  field {
+    id = "title"
+    name = "Title"
+    type = "Symbol"
+  }
+
+  field {
+    id = "body"
+    name = "Body"
+    type = "RichText"
+
+    rich_text {
+      enabled_node_types = [%s]
+      enabled_marks = ["bold", "italic"]
+
+      node {
+        type = "embedded-entry-block"
+        link_content_types = ["${contentful_contenttype.author.id}"]
+        max = 3
+      }
+    }
+  }
+}
+`, spaceName, nodeTypes)
+}
+
+func testAccContentfulContentTypeTypeChangeConfig(spaceName, summaryType string, replace bool) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  default_locale = "en-US"
+  deletion_protection = false
+}
+
+resource "contentful_contenttype" "page" {
+  space_id = "${contentful_space.myspace.id}"
+
+  name = "Page"
+  display_field = "title"
+  replace_on_type_change = %t
+
+  field {
+    id = "title"
+    name = "Title"
+    type = "Symbol"
+  }
+
+  field {
+    id = "summary"
+    name = "Summary"
+    type = "%s"
+  }
+}
+`, spaceName, replace, summaryType)
+}
+
+func testAccContentfulContentTypeRenameConfig(spaceName, slugID, previousID string) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  default_locale = "en-US"
+  deletion_protection = false
+}
+
+resource "contentful_contenttype" "page" {
+  space_id = "${contentful_space.myspace.id}"
+
+  name = "Page"
+  display_field = "title"
+  delete_entries_on_destroy = true
+
+  field {
+    id = "title"
+    name = "Title"
+    type = "Symbol"
+  }
+
+  field {
+    id = "%s"
+    previous_id = "%s"
+    name = "Slug"
+    type = "Symbol"
+  }
+}
+
+resource "contentful_entry" "home" {
+  space_id = "${contentful_space.myspace.id}"
+  content_type_id = "${contentful_contenttype.page.id}"
+
+  field {
+    id = "title"
+    locale = "en-US"
+    content = "\"Home\""
+  }
+
+  field {
+    id = "%s"
+    locale = "en-US"
+    content = "\"home\""
+  }
+}
+`, spaceName, slugID, previousID, slugID)
+}
+
+func testAccContentfulContentTypeDefinitionConfig(spaceName, fields string) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  default_locale = "en-US"
+  deletion_protection = false
+}
+
+resource "contentful_contenttype" "page" {
+  space_id = "${contentful_space.myspace.id}"
+
+  name = "Page"
+  display_field = "title"
+
+  definition_json = <<EOF
+{
+  "name": "Page",
+  "fields": [%s
+  ]
+}
+EOF
+}
+`, spaceName, fields)
+}
+
+func testAccContentfulContentTypeMigrationConfig(spaceName, migration string) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  default_locale = "en-US"
+  deletion_protection = false
+}
+
+resource "contentful_contenttype" "page" {
+  space_id = "${contentful_space.myspace.id}"
+
+  name = "Page"
+  display_field = "title"
+  delete_entries_on_destroy = true
+
+  field {
+    id = "title"
+    name = "Title"
+    type = "Symbol"
+  }
+
+  field {
+    id = "tags"
+    name = "Tags"
+    type = "Symbol"
+  }
+%s
+}
+`, spaceName, migration)
+}
+
+func testAccContentfulContentTypeLinkContentTypesConfig(spaceName, articleLinks string) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  default_locale = "en-US"
+  deletion_protection = false
+}
+
+# Article links back to author by literal ID, once both exist, to avoid a
+# dependency cycle.
+resource "contentful_contenttype" "article" {
+  space_id = "${contentful_space.myspace.id}"
+  content_type_id = "article"
+
+  name = "Article"
+  display_field = "title"
+
+  field {
+    id = "title"
+    name = "Title"
+    type = "Symbol"
+  }
+
+  field {
+    id = "authors"
+    name = "Authors"
+    type = "Array"
+
+    items {
+      type = "Link"
+      link_type = "Entry"
+      link_content_types = %s
+    }
+  }
+}
+
+resource "contentful_contenttype" "author" {
+  space_id = "${contentful_space.myspace.id}"
+  content_type_id = "author"
+
+  name = "Author"
+  display_field = "name"
+
+  field {
+    id = "name"
+    name = "Name"
+    type = "Symbol"
+  }
+
+  field {
+    id = "featured_article"
+    name = "Featured article"
+    type = "Link"
+    link_type = "Entry"
+    link_content_types = ["${contentful_contenttype.article.id}"]
+  }
+}
+`, spaceName, articleLinks)
+}
+
+func testAccContentfulContentTypeDeletionProtectionConfig(spaceName string, protect bool) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  default_locale = "en-US"
+  deletion_protection = false
+}
+
+resource "contentful_contenttype" "page" {
+  space_id = "${contentful_space.myspace.id}"
+
+  name = "Page"
+  display_field = "title"
+  deletion_protection = %t
+
+  field {
+    id = "title"
+    name = "Title"
+    type = "Symbol"
+  }
+}
+`, spaceName, protect)
+}
+
+func testAccContentfulContentTypeWithoutContentTypeConfig(spaceName string) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  default_locale = "en-US"
+  deletion_protection = false
+}
+`, spaceName)
+}
@@ -0,0 +1,483 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+// editorInterfaceTimeout is how long we wait for Contentful to create the
+// editor interface after its content type was activated.
+const editorInterfaceTimeout = time.Minute
+
+var widgetNamespaces = []string{"builtin", "extension", "app", "sidebar-builtin", "editor-builtin"}
+
+func resourceContentfulEditorInterface() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreateEditorInterface,
+		Read:   resourceReadEditorInterface,
+		Update: resourceUpdateEditorInterface,
+		Delete: resourceDeleteEditorInterface,
+
+		Importer: &schema.ResourceImporter{
+			State: importEnvironmentScoped,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"version": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"space_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "master",
+			},
+			"content_type_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// Fields without a control use the default widget of their type.
+			"control": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"widget_namespace": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "builtin",
+							ValidateFunc: validation.StringInSlice(widgetNamespaces, false),
+						},
+						"widget_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						// The JSON encoded widget settings, e.g.
+						// {"helpText": "Shown below the field", "trueLabel": "Yes"}
+						"settings": &schema.Schema{
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.ValidateJsonString,
+							DiffSuppressFunc: structure.SuppressJsonDiff,
+						},
+					},
+				},
+			},
+			// Replaces the default sidebar of the entry editor when set.
+			"sidebar": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"widget_namespace": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "sidebar-builtin",
+							ValidateFunc: validation.StringInSlice(widgetNamespaces, false),
+						},
+						"widget_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"settings": &schema.Schema{
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.ValidateJsonString,
+							DiffSuppressFunc: structure.SuppressJsonDiff,
+						},
+						"disabled": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			// Groups the fields of the entry editor into tabs.
+			"editor_layout": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"field_ids": &schema.Schema{
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type editorInterface struct {
+	Sys          *entitySys             `json:"sys,omitempty"`
+	Controls     []*editorControl       `json:"controls"`
+	Sidebar      []*editorSidebarWidget `json:"sidebar,omitempty"`
+	EditorLayout []*editorLayoutGroup   `json:"editorLayout,omitempty"`
+}
+
+type editorControl struct {
+	FieldID         string                 `json:"fieldId"`
+	WidgetNamespace string                 `json:"widgetNamespace,omitempty"`
+	WidgetID        string                 `json:"widgetId,omitempty"`
+	Settings        map[string]interface{} `json:"settings,omitempty"`
+}
+
+type editorSidebarWidget struct {
+	WidgetNamespace string                 `json:"widgetNamespace"`
+	WidgetID        string                 `json:"widgetId"`
+	Settings        map[string]interface{} `json:"settings,omitempty"`
+	Disabled        bool                   `json:"disabled,omitempty"`
+}
+
+type editorLayoutGroup struct {
+	GroupID string              `json:"groupId"`
+	Name    string              `json:"name"`
+	Items   []*editorLayoutItem `json:"items"`
+}
+
+type editorLayoutItem struct {
+	FieldID string `json:"fieldId"`
+}
+
+func editorInterfacePath(spaceID, environment, contentTypeID string) string {
+	return fmt.Sprintf("%s/content_types/%s/editor_interface", environmentPath(spaceID, environment), contentTypeID)
+}
+
+// getEditorInterface fetches the editor interface of the content type. It is
+// created by Contentful when the content type is activated, so a missing
+// editor interface is retried for a while.
+func getEditorInterface(client *contentful.Contentful, spaceID, environment, contentTypeID string) (*editorInterface, error) {
+	ei := &editorInterface{}
+
+	err := resource.Retry(editorInterfaceTimeout, func() *resource.RetryError {
+		err := cmaGet(client, editorInterfacePath(spaceID, environment, contentTypeID), nil, ei)
+		if isNotFound(err) {
+			return resource.RetryableError(fmt.Errorf("The editor interface of content type %s has not been created yet", contentTypeID))
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	return ei, err
+}
+
+func resourceCreateEditorInterface(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+	contentTypeID := d.Get("content_type_id").(string)
+
+	current, err := getEditorInterface(client, spaceID, environment, contentTypeID)
+	if err != nil {
+		return err
+	}
+
+	ei, err := buildEditorInterface(d, current)
+	if err != nil {
+		return err
+	}
+
+	err = cmaPut(client, editorInterfacePath(spaceID, environment, contentTypeID), current.Sys.Version, ei, ei)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(contentTypeID)
+
+	return setEditorInterfaceProperties(d, ei)
+}
+
+func resourceReadEditorInterface(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	ei := &editorInterface{}
+
+	err = cmaGet(client, editorInterfacePath(spaceID, environment, d.Id()), nil, ei)
+	if isNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return setEditorInterfaceProperties(d, ei)
+}
+
+func resourceUpdateEditorInterface(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+	path := editorInterfacePath(spaceID, environment, d.Id())
+
+	current := &editorInterface{}
+	if err = cmaGet(client, path, nil, current); err != nil {
+		return err
+	}
+
+	ei, err := buildEditorInterface(d, current)
+	if err != nil {
+		return err
+	}
+
+	if err = cmaPut(client, path, current.Sys.Version, ei, ei); err != nil {
+		return err
+	}
+
+	return setEditorInterfaceProperties(d, ei)
+}
+
+// resourceDeleteEditorInterface resets all fields to their default widgets,
+// the editor interface itself only goes away with its content type.
+func resourceDeleteEditorInterface(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+	path := editorInterfacePath(spaceID, environment, d.Id())
+
+	current := &editorInterface{}
+
+	err = cmaGet(client, path, nil, current)
+	if isNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	ei := &editorInterface{Controls: []*editorControl{}}
+	for _, control := range current.Controls {
+		ei.Controls = append(ei.Controls, &editorControl{FieldID: control.FieldID})
+	}
+
+	err = cmaPut(client, path, current.Sys.Version, ei, nil)
+	if isNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// buildEditorInterface builds the editor interface from the configuration.
+// Fields of the current editor interface without a configured control are
+// kept, so Contentful falls back to their default widget.
+func buildEditorInterface(d *schema.ResourceData, current *editorInterface) (*editorInterface, error) {
+	ei := &editorInterface{Controls: []*editorControl{}}
+	configured := map[string]bool{}
+
+	for _, rawControl := range d.Get("control").([]interface{}) {
+		control := rawControl.(map[string]interface{})
+
+		c := &editorControl{
+			FieldID:         control["field_id"].(string),
+			WidgetNamespace: control["widget_namespace"].(string),
+			WidgetID:        control["widget_id"].(string),
+		}
+
+		if err := expandWidgetSettings(control["settings"].(string), &c.Settings); err != nil {
+			return nil, fmt.Errorf("Invalid settings of the control of field %s: %s", c.FieldID, err)
+		}
+
+		ei.Controls = append(ei.Controls, c)
+		configured[c.FieldID] = true
+	}
+
+	for _, control := range current.Controls {
+		if !configured[control.FieldID] {
+			ei.Controls = append(ei.Controls, &editorControl{FieldID: control.FieldID})
+		}
+	}
+
+	for _, rawWidget := range d.Get("sidebar").([]interface{}) {
+		widget := rawWidget.(map[string]interface{})
+
+		w := &editorSidebarWidget{
+			WidgetNamespace: widget["widget_namespace"].(string),
+			WidgetID:        widget["widget_id"].(string),
+			Disabled:        widget["disabled"].(bool),
+		}
+
+		if err := expandWidgetSettings(widget["settings"].(string), &w.Settings); err != nil {
+			return nil, fmt.Errorf("Invalid settings of sidebar widget %s: %s", w.WidgetID, err)
+		}
+
+		ei.Sidebar = append(ei.Sidebar, w)
+	}
+
+	for _, rawGroup := range d.Get("editor_layout").([]interface{}) {
+		group := rawGroup.(map[string]interface{})
+
+		g := &editorLayoutGroup{
+			GroupID: group["group_id"].(string),
+			Name:    group["name"].(string),
+			Items:   []*editorLayoutItem{},
+		}
+
+		for _, fieldID := range group["field_ids"].([]interface{}) {
+			g.Items = append(g.Items, &editorLayoutItem{FieldID: fieldID.(string)})
+		}
+
+		ei.EditorLayout = append(ei.EditorLayout, g)
+	}
+
+	return ei, nil
+}
+
+func expandWidgetSettings(settings string, out *map[string]interface{}) error {
+	if settings == "" {
+		return nil
+	}
+
+	return json.Unmarshal([]byte(settings), out)
+}
+
+// flattenWidgetSettings encodes the settings of a widget. Encoding the decoded
+// settings normalizes their formatting and key order.
+func flattenWidgetSettings(settings map[string]interface{}) (string, error) {
+	if len(settings) == 0 {
+		return "", nil
+	}
+
+	encoded, err := json.Marshal(settings)
+
+	return string(encoded), err
+}
+
+// flattenEditorControls converts the controls of the editor interface into
+// control blocks. Only controls of fields already in the state are kept, in
+// the same order, as Contentful lists a control for every field. When nothing
+// is in the state yet, e.g. on import, all customized controls are returned.
+func flattenEditorControls(current []interface{}, controls []*editorControl) ([]interface{}, error) {
+	byField := map[string]*editorControl{}
+	for _, control := range controls {
+		byField[control.FieldID] = control
+	}
+
+	var fieldIDs []string
+	for _, rawControl := range current {
+		fieldIDs = append(fieldIDs, rawControl.(map[string]interface{})["field_id"].(string))
+	}
+
+	if len(current) == 0 {
+		for _, control := range controls {
+			if control.WidgetID != "" {
+				fieldIDs = append(fieldIDs, control.FieldID)
+			}
+		}
+	}
+
+	flattened := []interface{}{}
+	for _, fieldID := range fieldIDs {
+		control, ok := byField[fieldID]
+		if !ok || control.WidgetID == "" {
+			continue
+		}
+
+		settings, err := flattenWidgetSettings(control.Settings)
+		if err != nil {
+			return nil, err
+		}
+
+		flattened = append(flattened, map[string]interface{}{
+			"field_id":         control.FieldID,
+			"widget_namespace": control.WidgetNamespace,
+			"widget_id":        control.WidgetID,
+			"settings":         settings,
+		})
+	}
+
+	return flattened, nil
+}
+
+func setEditorInterfaceProperties(d *schema.ResourceData, ei *editorInterface) error {
+	if err := d.Set("version", ei.Sys.Version); err != nil {
+		return err
+	}
+
+	if ei.Sys.ContentType != nil {
+		if err := d.Set("content_type_id", ei.Sys.ContentType.Sys.ID); err != nil {
+			return err
+		}
+	}
+
+	controls, err := flattenEditorControls(d.Get("control").([]interface{}), ei.Controls)
+	if err != nil {
+		return err
+	}
+
+	if err = d.Set("control", controls); err != nil {
+		return err
+	}
+
+	sidebar := []interface{}{}
+	for _, widget := range ei.Sidebar {
+		settings, err := flattenWidgetSettings(widget.Settings)
+		if err != nil {
+			return err
+		}
+
+		sidebar = append(sidebar, map[string]interface{}{
+			"widget_namespace": widget.WidgetNamespace,
+			"widget_id":        widget.WidgetID,
+			"settings":         settings,
+			"disabled":         widget.Disabled,
+		})
+	}
+
+	if err = d.Set("sidebar", sidebar); err != nil {
+		return err
+	}
+
+	layout := []interface{}{}
+	for _, group := range ei.EditorLayout {
+		fieldIDs := []string{}
+		for _, item := range group.Items {
+			fieldIDs = append(fieldIDs, item.FieldID)
+		}
+
+		layout = append(layout, map[string]interface{}{
+			"group_id":  group.GroupID,
+			"name":      group.Name,
+			"field_ids": fieldIDs,
+		})
+	}
+
+	return d.Set("editor_layout", layout)
+}
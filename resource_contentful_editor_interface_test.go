@@ -0,0 +1,171 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+func TestAccContentfulEditorInterface_Basic(t *testing.T) {
+	spaceName := fmt.Sprintf("space-name-%s", acctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContentfulEditorInterfaceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulEditorInterfaceConfig(spaceName, "The URL of the page"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_editor_interface.page", "control.#", "2"),
+					resource.TestCheckResourceAttr("contentful_editor_interface.page", "control.0.widget_id", "slugEditor"),
+					resource.TestCheckResourceAttr("contentful_editor_interface.page", "editor_layout.0.field_ids.#", "3"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccContentfulEditorInterfaceConfig(spaceName, "Generated from the title"),
+				Check: resource.TestCheckResourceAttr(
+					"contentful_editor_interface.page", "control.0.settings", `{"helpText":"Generated from the title"}`),
+			},
+			resource.TestStep{
+				ResourceName:      "contentful_editor_interface.page",
+				ImportState:       true,
+				ImportStateIdFunc: testAccSpaceScopedImportID("contentful_editor_interface.page"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestFlattenEditorControls(t *testing.T) {
+	controls := []*editorControl{
+		&editorControl{FieldID: "title", WidgetNamespace: "builtin", WidgetID: "singleLine"},
+		&editorControl{FieldID: "slug", WidgetNamespace: "builtin", WidgetID: "slugEditor", Settings: map[string]interface{}{"helpText": "URL"}},
+		&editorControl{FieldID: "body"},
+	}
+
+	current := []interface{}{
+		map[string]interface{}{"field_id": "slug"},
+		map[string]interface{}{"field_id": "removed"},
+	}
+
+	flattened, err := flattenEditorControls(current, controls)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{"field_id": "slug", "widget_namespace": "builtin", "widget_id": "slugEditor", "settings": `{"helpText":"URL"}`},
+	}
+
+	if !reflect.DeepEqual(flattened, expected) {
+		t.Fatalf("unexpected controls: %#v", flattened)
+	}
+
+	// Without a state, e.g. on import, all customized controls are returned.
+	flattened, err = flattenEditorControls(nil, controls)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if len(flattened) != 2 {
+		t.Fatalf("unexpected controls: %#v", flattened)
+	}
+}
+
+func testAccCheckContentfulEditorInterfaceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*contentful.Contentful)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_editor_interface" {
+			continue
+		}
+
+		spaceID := rs.Primary.Attributes["space_id"]
+		environment := rs.Primary.Attributes["environment"]
+
+		ei := &editorInterface{}
+
+		err := cmaGet(client, editorInterfacePath(spaceID, environment, rs.Primary.ID), nil, ei)
+		if isNotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(ei.Sidebar) > 0 || len(ei.EditorLayout) > 0 {
+			return fmt.Errorf("Editor interface of content type %s was not reset", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccContentfulEditorInterfaceConfig(spaceName, helpText string) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  default_locale = "en-US"
+  deletion_protection = false
+}
+
+resource "contentful_contenttype" "page" {
+  space_id = "${contentful_space.myspace.id}"
+
+  name = "Page"
+  display_field = "title"
+
+  field {
+    id = "title"
+    name = "Title"
+    type = "Symbol"
+  }
+
+  field {
+    id = "slug"
+    name = "Slug"
+    type = "Symbol"
+  }
+
+  field {
+    id = "featured"
+    name = "Featured"
+    type = "Boolean"
+  }
+}
+
+resource "contentful_editor_interface" "page" {
+  space_id = "${contentful_space.myspace.id}"
+  content_type_id = "${contentful_contenttype.page.id}"
+
+  control {
+    field_id = "slug"
+    widget_id = "slugEditor"
+    settings = "{\"helpText\": \"%s\"}"
+  }
+
+  control {
+    field_id = "featured"
+    widget_id = "boolean"
+    settings = "{\"trueLabel\": \"Yes\", \"falseLabel\": \"No\"}"
+  }
+
+  sidebar {
+    widget_id = "publication-widget"
+  }
+
+  editor_layout {
+    group_id = "content"
+    name = "Content"
+    field_ids = ["title", "slug", "featured"]
+  }
+}
+`, spaceName, helpText)
+}
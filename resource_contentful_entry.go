@@ -0,0 +1,320 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+func resourceContentfulEntry() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreateEntry,
+		Read:   resourceReadEntry,
+		Update: resourceUpdateEntry,
+		Delete: resourceDeleteEntry,
+
+		Importer: &schema.ResourceImporter{
+			State: importEnvironmentScoped,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"version": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"space_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "master",
+			},
+			// The ID is generated by Contentful unless it is given here.
+			"entry_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"content_type_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"field": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"locale": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						// The JSON encoded value of the field in the locale,
+						// e.g. "\"Hello\"" or "true".
+						"content": &schema.Schema{
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.ValidateJsonString,
+							DiffSuppressFunc: structure.SuppressJsonDiff,
+						},
+					},
+				},
+			},
+			// IDs of the contentful_tag resources attached to the entry.
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"published": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceCreateEntry(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	e, err := buildEntry(d)
+	if err != nil {
+		return err
+	}
+
+	if entryID := d.Get("entry_id").(string); entryID != "" {
+		e.Sys = &entitySys{ID: entryID}
+	}
+
+	err = saveEntry(client, spaceID, environment, d.Get("content_type_id").(string), e)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(e.Sys.ID)
+
+	if d.Get("published").(bool) {
+		if err = publishEntry(client, spaceID, environment, e); err != nil {
+			return err
+		}
+	}
+
+	return setEntryProperties(d, e)
+}
+
+func resourceReadEntry(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	e := &entry{}
+
+	err = cmaGet(client, entryPath(spaceID, environment, d.Id()), nil, e)
+	if isNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return setEntryProperties(d, e)
+}
+
+func resourceUpdateEntry(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	e, err := buildEntry(d)
+	if err != nil {
+		return err
+	}
+
+	e.Sys = &entitySys{ID: d.Id(), Version: d.Get("version").(int)}
+
+	if d.HasChange("field") || d.HasChange("tags") {
+		err = saveEntry(client, spaceID, environment, d.Get("content_type_id").(string), e)
+		if err != nil {
+			return err
+		}
+	} else {
+		// Fetch the current sys, publishing requires the latest version.
+		if err = cmaGet(client, entryPath(spaceID, environment, d.Id()), nil, e); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("published").(bool) {
+		if !e.Sys.isPublished() || e.Sys.hasUnpublishedChanges() {
+			err = publishEntry(client, spaceID, environment, e)
+		}
+	} else if e.Sys.isPublished() {
+		err = unpublishEntry(client, spaceID, environment, e)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return setEntryProperties(d, e)
+}
+
+func resourceDeleteEntry(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	e := &entry{}
+
+	err = cmaGet(client, entryPath(spaceID, environment, d.Id()), nil, e)
+	if isNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return deleteEntry(client, spaceID, environment, e)
+}
+
+func buildEntry(d *schema.ResourceData) (*entry, error) {
+	e := &entry{
+		Metadata: newEntityMetadata(d.Get("tags").(*schema.Set)),
+		Fields:   map[string]map[string]interface{}{},
+	}
+
+	for _, rawField := range d.Get("field").([]interface{}) {
+		field := rawField.(map[string]interface{})
+		id := field["id"].(string)
+		locale := field["locale"].(string)
+
+		var content interface{}
+		if err := json.Unmarshal([]byte(field["content"].(string)), &content); err != nil {
+			return nil, fmt.Errorf("Invalid content of field %s in locale %s: %s", id, locale, err)
+		}
+
+		if e.Fields[id] == nil {
+			e.Fields[id] = map[string]interface{}{}
+		}
+
+		e.Fields[id][locale] = content
+	}
+
+	return e, nil
+}
+
+// flattenEntryFields converts the fields of an entry into field blocks. Fields
+// already in the state keep their position, so only real changes show up in
+// the plan. Remaining fields are appended in a stable order.
+func flattenEntryFields(current []interface{}, fields map[string]map[string]interface{}) ([]interface{}, error) {
+	flattened := []interface{}{}
+	seen := map[string]bool{}
+
+	appendField := func(id, locale string) error {
+		content, err := json.Marshal(fields[id][locale])
+		if err != nil {
+			return err
+		}
+
+		flattened = append(flattened, map[string]interface{}{
+			"id":      id,
+			"locale":  locale,
+			"content": string(content),
+		})
+		seen[id+"/"+locale] = true
+
+		return nil
+	}
+
+	for _, rawField := range current {
+		field := rawField.(map[string]interface{})
+		id := field["id"].(string)
+		locale := field["locale"].(string)
+
+		if _, ok := fields[id][locale]; !ok || seen[id+"/"+locale] {
+			continue
+		}
+
+		if err := appendField(id, locale); err != nil {
+			return nil, err
+		}
+	}
+
+	var ids []string
+	for id := range fields {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		var locales []string
+		for locale := range fields[id] {
+			locales = append(locales, locale)
+		}
+		sort.Strings(locales)
+
+		for _, locale := range locales {
+			if seen[id+"/"+locale] {
+				continue
+			}
+
+			if err := appendField(id, locale); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return flattened, nil
+}
+
+func setEntryProperties(d *schema.ResourceData, e *entry) error {
+	if err := d.Set("version", e.Sys.Version); err != nil {
+		return err
+	}
+
+	if err := d.Set("entry_id", e.Sys.ID); err != nil {
+		return err
+	}
+
+	if e.Sys.ContentType != nil {
+		if err := d.Set("content_type_id", e.Sys.ContentType.Sys.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("tags", metadataTagIDs(e.Metadata)); err != nil {
+		return err
+	}
+
+	fields, err := flattenEntryFields(d.Get("field").([]interface{}), e.Fields)
+	if err != nil {
+		return err
+	}
+
+	if err = d.Set("field", fields); err != nil {
+		return err
+	}
+
+	return d.Set("published", e.Sys.isPublished() && !e.Sys.hasUnpublishedChanges())
+}
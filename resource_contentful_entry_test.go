@@ -0,0 +1,142 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+func TestAccContentfulEntry_Basic(t *testing.T) {
+	spaceName := fmt.Sprintf("space-name-%s", acctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContentfulEntryDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulEntryConfig(spaceName, "Hello", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "entry_id", "settings"),
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "published", "true"),
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "field.0.content", `"Hello"`),
+				),
+			},
+			resource.TestStep{
+				Config: testAccContentfulEntryConfig(spaceName, "Hello world", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "published", "false"),
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "field.0.content", `"Hello world"`),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "contentful_entry.myentry",
+				ImportState:       true,
+				ImportStateIdFunc: testAccSpaceScopedImportID("contentful_entry.myentry"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestFlattenEntryFields(t *testing.T) {
+	current := []interface{}{
+		map[string]interface{}{"id": "title", "locale": "en-US", "content": `"Old"`},
+		map[string]interface{}{"id": "enabled", "locale": "en-US", "content": "false"},
+	}
+
+	fields := map[string]map[string]interface{}{
+		"enabled": {"en-US": true},
+		"title":   {"en-US": "New", "de": "Neu"},
+	}
+
+	flattened, err := flattenEntryFields(current, fields)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{"id": "title", "locale": "en-US", "content": `"New"`},
+		map[string]interface{}{"id": "enabled", "locale": "en-US", "content": "true"},
+		map[string]interface{}{"id": "title", "locale": "de", "content": `"Neu"`},
+	}
+
+	if !reflect.DeepEqual(flattened, expected) {
+		t.Fatalf("unexpected fields: %#v", flattened)
+	}
+}
+
+func testAccCheckContentfulEntryDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*contentful.Contentful)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_entry" {
+			continue
+		}
+
+		spaceID := rs.Primary.Attributes["space_id"]
+		environment := rs.Primary.Attributes["environment"]
+
+		err := cmaGet(client, entryPath(spaceID, environment, rs.Primary.ID), nil, &entry{})
+		if err == nil {
+			return fmt.Errorf("Entry still exists with id: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccContentfulEntryConfig(spaceName, title string, published bool) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  default_locale = "en-US"
+  deletion_protection = false
+}
+
+resource "contentful_contenttype" "settings" {
+  space_id = "${contentful_space.myspace.id}"
+
+  name = "Settings"
+  display_field = "title"
+
+  field {
+    id = "title"
+    name = "Title"
+    type = "Symbol"
+    required = true
+  }
+
+  field {
+    id = "maintenance"
+    name = "Maintenance"
+    type = "Boolean"
+  }
+}
+
+resource "contentful_entry" "myentry" {
+  space_id = "${contentful_space.myspace.id}"
+  entry_id = "settings"
+  content_type_id = "${contentful_contenttype.settings.id}"
+
+  field {
+    id = "title"
+    locale = "en-US"
+    content = "\"%s\""
+  }
+
+  field {
+    id = "maintenance"
+    locale = "en-US"
+    content = "false"
+  }
+
+  published = %t
+}
+`, spaceName, title, published)
+}
@@ -1,6 +1,9 @@
 package main
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	contentful "github.com/tolgaakyuz/contentful-go"
 )
@@ -12,6 +15,8 @@ func resourceContentfulLocale() *schema.Resource {
 		Update: resourceUpdateLocale,
 		Delete: resourceDeleteLocale,
 
+		CustomizeDiff: resourceLocaleCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"version": &schema.Schema{
 				Type:     schema.TypeInt,
@@ -29,6 +34,13 @@ func resourceContentfulLocale() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			// Changing the code of an existing locale re-keys all localized
+			// entry content, so it has to be opted into explicitly.
+			"allow_code_change": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"fallback_code": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -109,6 +121,14 @@ func resourceUpdateLocale(d *schema.ResourceData, m interface{}) (err error) {
 		return err
 	}
 
+	if d.HasChange("code") {
+		oldCode, _ := d.GetChange("code")
+
+		if err = checkLocaleNotFallback(client, spaceID, oldCode.(string)); err != nil {
+			return err
+		}
+	}
+
 	locale.Name = d.Get("name").(string)
 	locale.Code = d.Get("code").(string)
 	locale.FallbackCode = d.Get("fallback_code").(string)
@@ -151,6 +171,50 @@ func resourceDeleteLocale(d *schema.ResourceData, m interface{}) (err error) {
 	return nil
 }
 
+func resourceLocaleCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.HasChange("code") {
+		return nil
+	}
+
+	if d.Get("allow_code_change").(bool) {
+		return nil
+	}
+
+	oldCode, newCode := d.GetChange("code")
+
+	return fmt.Errorf(
+		"Changing the code of locale %s from %q to %q re-keys all localized content of the space. "+
+			"Set allow_code_change = true to confirm the change",
+		d.Id(), oldCode.(string), newCode.(string),
+	)
+}
+
+// checkLocaleNotFallback returns an error when other locales of the space use
+// the given code as their fallback. Contentful rejects code changes of such
+// locales, so we fail early with the list of locales that need updating first.
+func checkLocaleNotFallback(client *contentful.Contentful, spaceID, code string) error {
+	locales, err := listLocales(client, spaceID, "")
+	if err != nil {
+		return err
+	}
+
+	var dependents []string
+	for _, locale := range locales {
+		if locale.FallbackCode == code {
+			dependents = append(dependents, locale.Code)
+		}
+	}
+
+	if len(dependents) > 0 {
+		return fmt.Errorf(
+			"Locale %q is the fallback of locale(s) %s. Change their fallback_code before changing its code",
+			code, strings.Join(dependents, ", "),
+		)
+	}
+
+	return nil
+}
+
 func setLocaleProperties(d *schema.ResourceData, locale *contentful.Locale) error {
 	err := d.Set("name", locale.Name)
 	if err != nil {
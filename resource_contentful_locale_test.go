@@ -1,7 +1,12 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -35,6 +40,10 @@ func TestAccContentfulLocales_Basic(t *testing.T) {
 					}),
 				),
 			},
+			resource.TestStep{
+				Config:      testAccContentfulLocaleCodeChangeConfig(spaceName, name),
+				ExpectError: regexp.MustCompile("allow_code_change"),
+			},
 			resource.TestStep{
 				Config: testAccContentfulLocaleUpdateConfig(spaceName, name),
 				Check: resource.ComposeTestCheckFunc(
@@ -53,6 +62,34 @@ func TestAccContentfulLocales_Basic(t *testing.T) {
 	})
 }
 
+func TestCheckLocaleNotFallback(t *testing.T) {
+	client, teardown := testCMAServer(t, func(w http.ResponseWriter, r *http.Request) {
+		skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+
+		page := collection{Total: collectionPageSize + 1, Skip: skip}
+		for i := skip; i < skip+collectionPageSize && i < page.Total; i++ {
+			page.Items = append(page.Items, json.RawMessage(fmt.Sprintf(`{"code": "l%d", "fallbackCode": "en-US"}`, i)))
+		}
+
+		// Only the locale on the second page falls back to de-DE.
+		if skip > 0 {
+			page.Items[0] = json.RawMessage(`{"code": "de-AT", "fallbackCode": "de-DE"}`)
+		}
+
+		json.NewEncoder(w).Encode(page)
+	})
+	defer teardown()
+
+	err := checkLocaleNotFallback(client, "space", "de-DE")
+	if err == nil || !strings.Contains(err.Error(), "de-AT") {
+		t.Fatalf("expected the fallback on the second page to be found, got %v", err)
+	}
+
+	if err = checkLocaleNotFallback(client, "space", "fr-FR"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
 func testAccCheckContentfulLocaleExists(n string, locale *contentful.Locale) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -152,6 +189,7 @@ func testAccContentfulLocaleConfig(spaceName, name string) string {
 	return fmt.Sprintf(`
 resource "contentful_space" "myspace" {
   name = "%s"
+  deletion_protection = false
   default_locale = "en-US"
 }
 
@@ -172,6 +210,7 @@ func testAccContentfulLocaleUpdateConfig(spaceName, name string) string {
 	return fmt.Sprintf(`
 resource "contentful_space" "myspace" {
   name = "%s"
+  deletion_protection = false
   default_locale = "en-US"
 }
 
@@ -180,6 +219,7 @@ resource "contentful_locale" "mylocale" {
 
   name = "%s-updated"
   code = "es"
+  allow_code_change = true
   fallback_code = "en-US"
   optional = true
   cda = true
@@ -187,3 +227,24 @@ resource "contentful_locale" "mylocale" {
 }
 `, spaceName, name)
 }
+
+func testAccContentfulLocaleCodeChangeConfig(spaceName, name string) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  deletion_protection = false
+  default_locale = "en-US"
+}
+
+resource "contentful_locale" "mylocale" {
+  space_id = "${contentful_space.myspace.id}"
+
+  name = "%s"
+  code = "es"
+  fallback_code = "en-US"
+  optional = false
+  cda = false
+  cma = true
+}
+`, spaceName, name)
+}
@@ -0,0 +1,362 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+const (
+	organizationMembershipActive  = "active"
+	organizationMembershipPending = "pending"
+)
+
+func resourceContentfulOrganizationMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreateOrganizationMembership,
+		Read:   resourceReadOrganizationMembership,
+		Update: resourceUpdateOrganizationMembership,
+		Delete: resourceDeleteOrganizationMembership,
+
+		Schema: map[string]*schema.Schema{
+			"version": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"email": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"owner", "admin", "member"}, false),
+			},
+			"first_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"last_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			// Only used when the user has to be invited to the organization.
+			"suppress_invitation_email": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// Either "active" or "pending" while the invitation has not been
+			// accepted yet.
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type organizationMembership struct {
+	Sys  *entitySys `json:"sys,omitempty"`
+	Role string     `json:"role"`
+}
+
+type organizationInvitation struct {
+	Sys                *entitySys `json:"sys,omitempty"`
+	Email              string     `json:"email"`
+	FirstName          string     `json:"firstName,omitempty"`
+	LastName           string     `json:"lastName,omitempty"`
+	Role               string     `json:"role"`
+	SuppressInvitation bool       `json:"suppressInvitation,omitempty"`
+}
+
+// organizationMember is an organization membership joined with its user.
+type organizationMember struct {
+	ID        string
+	Version   int
+	Role      string
+	UserID    string
+	Email     string
+	FirstName string
+	LastName  string
+}
+
+type organizationUser struct {
+	Sys       *entitySys `json:"sys"`
+	Email     string     `json:"email"`
+	FirstName string     `json:"firstName"`
+	LastName  string     `json:"lastName"`
+}
+
+func organizationMembershipsPath(client *contentful.Contentful) string {
+	return organizationPath(client) + "/organization_memberships"
+}
+
+func organizationMembershipPath(client *contentful.Contentful, membershipID string) string {
+	return fmt.Sprintf("%s/%s", organizationMembershipsPath(client), membershipID)
+}
+
+func organizationInvitationPath(client *contentful.Contentful, invitationID string) string {
+	return fmt.Sprintf("%s/invitations/%s", organizationPath(client), invitationID)
+}
+
+// listOrganizationMembers returns all members of the organization together
+// with the users they belong to.
+func listOrganizationMembers(client *contentful.Contentful) ([]*organizationMember, error) {
+	var members []*organizationMember
+
+	query := url.Values{}
+	query.Set("include", "sys.user")
+	query.Set("limit", strconv.Itoa(collectionPageSize))
+
+	for skip := 0; ; skip += collectionPageSize {
+		query.Set("skip", strconv.Itoa(skip))
+
+		var page struct {
+			Total    int                       `json:"total"`
+			Items    []*organizationMembership `json:"items"`
+			Includes struct {
+				User []*organizationUser `json:"User"`
+			} `json:"includes"`
+		}
+
+		if err := cmaGet(client, organizationMembershipsPath(client), query, &page); err != nil {
+			return nil, err
+		}
+
+		users := map[string]*organizationUser{}
+		for _, user := range page.Includes.User {
+			users[user.Sys.ID] = user
+		}
+
+		for _, membership := range page.Items {
+			member := &organizationMember{
+				ID:      membership.Sys.ID,
+				Version: membership.Sys.Version,
+				Role:    membership.Role,
+			}
+
+			if membership.Sys.User != nil {
+				member.UserID = membership.Sys.User.Sys.ID
+			}
+
+			if user, ok := users[member.UserID]; ok {
+				member.Email = user.Email
+				member.FirstName = user.FirstName
+				member.LastName = user.LastName
+			}
+
+			members = append(members, member)
+		}
+
+		if len(page.Items) == 0 || skip+len(page.Items) >= page.Total {
+			return members, nil
+		}
+	}
+}
+
+func findOrganizationMember(members []*organizationMember, email string) *organizationMember {
+	for _, member := range members {
+		if strings.EqualFold(member.Email, email) {
+			return member
+		}
+	}
+
+	return nil
+}
+
+func resourceCreateOrganizationMembership(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	email := d.Get("email").(string)
+	role := d.Get("role").(string)
+
+	members, err := listOrganizationMembers(client)
+	if err != nil {
+		return err
+	}
+
+	// Users that are already part of the organization are adopted.
+	if member := findOrganizationMember(members, email); member != nil {
+		if err = adoptOrganizationMember(client, member, role); err != nil {
+			return err
+		}
+
+		d.SetId(member.ID)
+
+		return setOrganizationMemberProperties(d, member)
+	}
+
+	invitation := &organizationInvitation{
+		Email:              email,
+		FirstName:          d.Get("first_name").(string),
+		LastName:           d.Get("last_name").(string),
+		Role:               role,
+		SuppressInvitation: d.Get("suppress_invitation_email").(bool),
+	}
+
+	err = cmaPost(client, organizationPath(client)+"/invitations", invitation, invitation)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(invitation.Sys.ID)
+
+	if err = d.Set("status", organizationMembershipPending); err != nil {
+		return err
+	}
+
+	return d.Set("version", invitation.Sys.Version)
+}
+
+func resourceReadOrganizationMembership(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+
+	members, err := listOrganizationMembers(client)
+	if err != nil {
+		return err
+	}
+
+	// Once an invitation is accepted the resource tracks the membership.
+	if member := findOrganizationMember(members, d.Get("email").(string)); member != nil {
+		d.SetId(member.ID)
+		return setOrganizationMemberProperties(d, member)
+	}
+
+	if d.Get("status").(string) != organizationMembershipPending {
+		d.SetId("")
+		return nil
+	}
+
+	err = cmaGet(client, organizationInvitationPath(client, d.Id()), nil, &organizationInvitation{})
+	if isNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	return err
+}
+
+func resourceUpdateOrganizationMembership(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+
+	if !d.HasChange("role") {
+		return nil
+	}
+
+	if d.Get("status").(string) == organizationMembershipPending {
+		return fmt.Errorf("The invitation of %s has not been accepted yet, its role cannot be changed", d.Get("email").(string))
+	}
+
+	oldRole, newRole := d.GetChange("role")
+	if oldRole.(string) == "owner" {
+		if err = checkNotLastOwner(client, d.Get("email").(string)); err != nil {
+			return err
+		}
+	}
+
+	membership := &organizationMembership{Role: newRole.(string)}
+
+	err = cmaPut(client, organizationMembershipPath(client, d.Id()), d.Get("version").(int), membership, membership)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("version", membership.Sys.Version)
+}
+
+func resourceDeleteOrganizationMembership(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+
+	if d.Get("status").(string) == organizationMembershipPending {
+		err = cmaDelete(client, organizationInvitationPath(client, d.Id()), 0, nil)
+		if isNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if d.Get("role").(string) == "owner" {
+		if err = checkNotLastOwner(client, d.Get("email").(string)); err != nil {
+			return err
+		}
+	}
+
+	err = cmaDelete(client, organizationMembershipPath(client, d.Id()), 0, nil)
+	if isNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// adoptOrganizationMember gives an existing member the configured role. Like
+// updates, it refuses to demote the last owner of the organization.
+func adoptOrganizationMember(client *contentful.Contentful, member *organizationMember, role string) error {
+	if member.Role == role {
+		return nil
+	}
+
+	if member.Role == "owner" {
+		if err := checkNotLastOwner(client, member.Email); err != nil {
+			return err
+		}
+	}
+
+	membership := &organizationMembership{Role: role}
+
+	err := cmaPut(client, organizationMembershipPath(client, member.ID), member.Version, membership, membership)
+	if err != nil {
+		return err
+	}
+
+	member.Version = membership.Sys.Version
+	member.Role = membership.Role
+
+	return nil
+}
+
+// checkNotLastOwner fails when the member with the given email is the only
+// owner left, as the organization would become unmanageable without it.
+func checkNotLastOwner(client *contentful.Contentful, email string) error {
+	members, err := listOrganizationMembers(client)
+	if err != nil {
+		return err
+	}
+
+	for _, member := range members {
+		if member.Role == "owner" && !strings.EqualFold(member.Email, email) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s is the last owner of the organization and cannot be removed or demoted", email)
+}
+
+func setOrganizationMemberProperties(d *schema.ResourceData, member *organizationMember) error {
+	if err := d.Set("version", member.Version); err != nil {
+		return err
+	}
+
+	if err := d.Set("role", member.Role); err != nil {
+		return err
+	}
+
+	if err := d.Set("user_id", member.UserID); err != nil {
+		return err
+	}
+
+	return d.Set("status", organizationMembershipActive)
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+func TestAccContentfulOrganizationMembership_Basic(t *testing.T) {
+	email := os.Getenv("CONTENTFUL_TEST_INVITE_EMAIL")
+	if email == "" {
+		t.Skip("CONTENTFUL_TEST_INVITE_EMAIL must be set for organization membership tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContentfulOrganizationMembershipDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulOrganizationMembershipConfig(email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"contentful_organization_membership.mymembership", "role", "member"),
+					resource.TestCheckResourceAttrSet(
+						"contentful_organization_membership.mymembership", "status"),
+				),
+			},
+		},
+	})
+}
+
+func TestCheckNotLastOwner(t *testing.T) {
+	client, teardown := testCMAServer(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+  "total": 2,
+  "items": [
+    {"role": "owner", "sys": {"id": "m1", "user": {"sys": {"id": "u1"}}}},
+    {"role": "member", "sys": {"id": "m2", "user": {"sys": {"id": "u2"}}}}
+  ],
+  "includes": {"User": [
+    {"email": "owner@example.com", "sys": {"id": "u1"}},
+    {"email": "member@example.com", "sys": {"id": "u2"}}
+  ]}
+}`)
+	})
+	defer teardown()
+
+	members, err := listOrganizationMembers(client)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if member := findOrganizationMember(members, "MEMBER@example.com"); member == nil || member.ID != "m2" {
+		t.Fatalf("expected to find membership m2, got %v", member)
+	}
+
+	if err = checkNotLastOwner(client, "owner@example.com"); err == nil {
+		t.Fatal("expected removing the last owner to fail")
+	}
+
+	if err = checkNotLastOwner(client, "member@example.com"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAdoptOrganizationMember_LastOwner(t *testing.T) {
+	client, teardown := testCMAServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+
+		fmt.Fprint(w, `{
+  "total": 1,
+  "items": [
+    {"role": "owner", "sys": {"id": "m1", "version": 3, "user": {"sys": {"id": "u1"}}}}
+  ],
+  "includes": {"User": [
+    {"email": "owner@example.com", "sys": {"id": "u1"}}
+  ]}
+}`)
+	})
+	defer teardown()
+
+	members, err := listOrganizationMembers(client)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	member := findOrganizationMember(members, "owner@example.com")
+
+	if err = adoptOrganizationMember(client, member, "member"); err == nil {
+		t.Fatal("expected demoting the last owner to fail")
+	}
+
+	if member.Role != "owner" {
+		t.Fatalf("expected the role to stay owner, got %s", member.Role)
+	}
+
+	// Keeping the role needs no request at all.
+	if err = adoptOrganizationMember(client, member, "owner"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func testAccCheckContentfulOrganizationMembershipDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*contentful.Contentful)
+
+	members, err := listOrganizationMembers(client)
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_organization_membership" {
+			continue
+		}
+
+		if member := findOrganizationMember(members, rs.Primary.Attributes["email"]); member != nil {
+			return fmt.Errorf("Organization membership still exists with id: %s", member.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccContentfulOrganizationMembershipConfig(email string) string {
+	return fmt.Sprintf(`
+resource "contentful_organization_membership" "mymembership" {
+  email = "%s"
+  role = "member"
+  suppress_invitation_email = true
+}
+`, email)
+}
@@ -0,0 +1,312 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+// rolePermissions maps the permission attributes to their API names.
+var rolePermissions = map[string]string{
+	"content_model":       "ContentModel",
+	"settings":            "Settings",
+	"content_delivery":    "ContentDelivery",
+	"environments":        "Environments",
+	"environment_aliases": "EnvironmentAliases",
+	"tags":                "Tags",
+}
+
+func resourceContentfulRole() *schema.Resource {
+	permissionsSchema := map[string]*schema.Schema{}
+	for attribute := range rolePermissions {
+		permissionsSchema[attribute] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		}
+	}
+
+	return &schema.Resource{
+		Create: resourceCreateRole,
+		Read:   resourceReadRole,
+		Update: resourceUpdateRole,
+		Delete: resourceDeleteRole,
+
+		Importer: &schema.ResourceImporter{
+			State: importSpaceScoped,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"version": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"space_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Each permission is a list of actions, e.g. ["read"] or ["all"].
+			"permissions": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: permissionsSchema,
+				},
+			},
+			"policy": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"effect": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"allow", "deny"}, false),
+						},
+						"actions": &schema.Schema{
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						// The constraint is a JSON encoded expression, e.g.
+						// {"and": [{"equals": [{"doc": "sys.type"}, "Entry"]}]}
+						"constraint": &schema.Schema{
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.ValidateJsonString,
+							DiffSuppressFunc: structure.SuppressJsonDiff,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type role struct {
+	Sys         *entitySys             `json:"sys,omitempty"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Permissions map[string]interface{} `json:"permissions"`
+	Policies    []*rolePolicy          `json:"policies"`
+}
+
+type rolePolicy struct {
+	Effect     string      `json:"effect"`
+	Actions    interface{} `json:"actions"`
+	Constraint interface{} `json:"constraint,omitempty"`
+}
+
+func rolesPath(spaceID string) string {
+	return fmt.Sprintf("/spaces/%s/roles", spaceID)
+}
+
+func rolePath(spaceID, roleID string) string {
+	return fmt.Sprintf("%s/%s", rolesPath(spaceID), roleID)
+}
+
+func resourceCreateRole(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+
+	r, err := buildRole(d)
+	if err != nil {
+		return err
+	}
+
+	err = cmaPost(client, rolesPath(spaceID), r, r)
+	if err != nil {
+		return err
+	}
+
+	err = setRoleProperties(d, r)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(r.Sys.ID)
+
+	return nil
+}
+
+func resourceReadRole(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+
+	r := &role{}
+
+	err = cmaGet(client, rolePath(spaceID, d.Id()), nil, r)
+	if isNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return setRoleProperties(d, r)
+}
+
+func resourceUpdateRole(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+
+	r, err := buildRole(d)
+	if err != nil {
+		return err
+	}
+
+	err = cmaPut(client, rolePath(spaceID, d.Id()), d.Get("version").(int), r, r)
+	if err != nil {
+		return err
+	}
+
+	return setRoleProperties(d, r)
+}
+
+func resourceDeleteRole(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+
+	err = cmaDelete(client, rolePath(spaceID, d.Id()), 0, nil)
+	if isNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+func buildRole(d *schema.ResourceData) (*role, error) {
+	r := &role{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Permissions: map[string]interface{}{},
+		Policies:    []*rolePolicy{},
+	}
+
+	for _, rawPermissions := range d.Get("permissions").([]interface{}) {
+		if rawPermissions == nil {
+			continue
+		}
+
+		permissions := rawPermissions.(map[string]interface{})
+		for attribute, name := range rolePermissions {
+			if actions := permissions[attribute].([]interface{}); len(actions) > 0 {
+				r.Permissions[name] = expandRoleActions(actions)
+			}
+		}
+	}
+
+	for _, rawPolicy := range d.Get("policy").([]interface{}) {
+		policy := rawPolicy.(map[string]interface{})
+
+		p := &rolePolicy{
+			Effect:  policy["effect"].(string),
+			Actions: expandRoleActions(policy["actions"].([]interface{})),
+		}
+
+		if constraint := policy["constraint"].(string); constraint != "" {
+			if err := json.Unmarshal([]byte(constraint), &p.Constraint); err != nil {
+				return nil, fmt.Errorf("Invalid policy constraint %s: %s", constraint, err)
+			}
+		}
+
+		r.Policies = append(r.Policies, p)
+	}
+
+	return r, nil
+}
+
+// expandRoleActions converts a list of actions into the API format, where
+// all actions are expressed as "all" instead of a list.
+func expandRoleActions(actions []interface{}) interface{} {
+	if len(actions) == 1 && actions[0].(string) == "all" {
+		return "all"
+	}
+
+	return actions
+}
+
+// flattenRoleActions converts actions returned by the API, either "all" or a
+// list of actions, into a list.
+func flattenRoleActions(actions interface{}) []string {
+	switch a := actions.(type) {
+	case string:
+		return []string{a}
+	case []interface{}:
+		flattened := []string{}
+		for _, action := range a {
+			flattened = append(flattened, action.(string))
+		}
+		return flattened
+	}
+
+	return []string{}
+}
+
+func setRoleProperties(d *schema.ResourceData, r *role) error {
+	if err := d.Set("version", r.Sys.Version); err != nil {
+		return err
+	}
+
+	if err := d.Set("name", r.Name); err != nil {
+		return err
+	}
+
+	if err := d.Set("description", r.Description); err != nil {
+		return err
+	}
+
+	permissions := map[string]interface{}{}
+	for attribute, name := range rolePermissions {
+		if actions, ok := r.Permissions[name]; ok {
+			permissions[attribute] = flattenRoleActions(actions)
+		}
+	}
+
+	if len(permissions) > 0 {
+		if err := d.Set("permissions", []interface{}{permissions}); err != nil {
+			return err
+		}
+	} else if err := d.Set("permissions", nil); err != nil {
+		return err
+	}
+
+	policies := []interface{}{}
+	for _, p := range r.Policies {
+		policy := map[string]interface{}{
+			"effect":  p.Effect,
+			"actions": flattenRoleActions(p.Actions),
+		}
+
+		// Encoding the decoded constraint normalizes its formatting and key
+		// order, which keeps it from showing up as a diff.
+		if p.Constraint != nil {
+			constraint, err := json.Marshal(p.Constraint)
+			if err != nil {
+				return err
+			}
+			policy["constraint"] = string(constraint)
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return d.Set("policy", policies)
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+func TestAccContentfulRole_Basic(t *testing.T) {
+	spaceName := fmt.Sprintf("space-name-%s", acctest.RandString(3))
+	name := fmt.Sprintf("role-name-%s", acctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContentfulRoleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulRoleConfig(spaceName, name, "read"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_role.myrole", "name", name),
+					resource.TestCheckResourceAttr("contentful_role.myrole", "permissions.0.content_model.0", "read"),
+					resource.TestCheckResourceAttr("contentful_role.myrole", "policy.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccContentfulRoleConfig(spaceName, name, "all"),
+				Check: resource.TestCheckResourceAttr(
+					"contentful_role.myrole", "permissions.0.content_model.0", "all"),
+			},
+			resource.TestStep{
+				ResourceName:      "contentful_role.myrole",
+				ImportState:       true,
+				ImportStateIdFunc: testAccSpaceScopedImportID("contentful_role.myrole"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestFlattenRoleActions(t *testing.T) {
+	if actions := flattenRoleActions("all"); !reflect.DeepEqual(actions, []string{"all"}) {
+		t.Fatalf("unexpected actions: %v", actions)
+	}
+
+	actions := flattenRoleActions([]interface{}{"read", "create"})
+	if !reflect.DeepEqual(actions, []string{"read", "create"}) {
+		t.Fatalf("unexpected actions: %v", actions)
+	}
+
+	if expanded := expandRoleActions([]interface{}{"all"}); expanded != "all" {
+		t.Fatalf("unexpected expanded actions: %v", expanded)
+	}
+}
+
+func testAccCheckContentfulRoleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*contentful.Contentful)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_role" {
+			continue
+		}
+
+		spaceID := rs.Primary.Attributes["space_id"]
+
+		err := cmaGet(client, rolePath(spaceID, rs.Primary.ID), nil, &role{})
+		if err == nil {
+			return fmt.Errorf("Role still exists with id: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccContentfulRoleConfig(spaceName, name, contentModel string) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  deletion_protection = false
+}
+
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "${contentful_space.myspace.id}"
+
+  name = "TF Acc Test CT"
+  display_field = "title"
+
+  field {
+    id = "title"
+    name = "Title"
+    type = "Symbol"
+    required = true
+  }
+}
+
+resource "contentful_role" "myrole" {
+  space_id = "${contentful_space.myspace.id}"
+
+  name = "%s"
+  description = "Editors of the test content type"
+
+  permissions {
+    content_model = ["%s"]
+    settings = []
+  }
+
+  policy {
+    effect = "allow"
+    actions = ["read", "update", "publish"]
+    constraint = <<JSON
+{
+  "and": [
+    {"equals": [{"doc": "sys.type"}, "Entry"]},
+    {"in": [{"doc": "sys.contentType.sys.id"}, ["${contentful_contenttype.mycontenttype.id}"]]}
+  ]
+}
+JSON
+  }
+
+  policy {
+    effect = "deny"
+    actions = ["delete"]
+    constraint = "{\"equals\": [{\"doc\": \"sys.type\"}, \"Entry\"]}"
+  }
+}
+`, spaceName, name, contentModel)
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	contentful "github.com/tolgaakyuz/contentful-go"
 )
@@ -12,6 +14,12 @@ func resourceContentfulSpace() *schema.Resource {
 		Update: resourceSpaceUpdate,
 		Delete: resourceSpaceDelete,
 
+		CustomizeDiff: resourceSpaceCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceSpaceImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"version": &schema.Schema{
 				Type:     schema.TypeInt,
@@ -27,6 +35,21 @@ func resourceContentfulSpace() *schema.Resource {
 				Optional: true,
 				Default:  "en",
 			},
+			// Changing default_locale changes the code of the default locale
+			// in place, which re-keys all localized content, so it has to be
+			// opted into explicitly.
+			"allow_default_locale_change": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			// Deleting a space deletes all of its content, so it has to be
+			// switched off in a prior apply before the space can be destroyed.
+			"deletion_protection": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 		},
 	}
 }
@@ -58,24 +81,69 @@ func resourceSpaceRead(d *schema.ResourceData, m interface{}) error {
 	client := m.(*contentful.Contentful)
 	spaceID := d.Id()
 
-	_, err := client.Spaces.Get(spaceID)
+	space, err := client.Spaces.Get(spaceID)
 	if _, ok := err.(contentful.NotFoundError); ok {
 		d.SetId("")
 		return nil
 	}
 
-	return err
+	if err != nil {
+		return err
+	}
+
+	err = updateSpaceProperties(d, space)
+	if err != nil {
+		return err
+	}
+
+	// The space payload does not carry its default locale, so it is derived
+	// from the locale flagged as default.
+	locale, err := getDefaultLocale(client, spaceID)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("default_locale", locale.Code)
+}
+
+func resourceSpaceCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.HasChange("default_locale") {
+		return nil
+	}
+
+	if d.Get("allow_default_locale_change").(bool) {
+		return nil
+	}
+
+	oldCode, newCode := d.GetChange("default_locale")
+
+	return fmt.Errorf(
+		"Changing the default locale of space %s from %q to %q re-keys all localized content of the space. "+
+			"Set allow_default_locale_change = true to confirm the change",
+		d.Id(), oldCode.(string), newCode.(string),
+	)
 }
 
 func resourceSpaceUpdate(d *schema.ResourceData, m interface{}) (err error) {
 	client := m.(*contentful.Contentful)
 	spaceID := d.Id()
 
+	if !d.HasChange("name") && !d.HasChange("default_locale") {
+		return nil
+	}
+
 	space, err := client.Spaces.Get(spaceID)
 	if err != nil {
 		return err
 	}
 
+	if d.HasChange("default_locale") {
+		err = updateDefaultLocale(client, spaceID, d.Get("default_locale").(string))
+		if err != nil {
+			return err
+		}
+	}
+
 	space.Name = d.Get("name").(string)
 
 	err = client.Spaces.Upsert(space)
@@ -90,6 +158,13 @@ func resourceSpaceDelete(d *schema.ResourceData, m interface{}) (err error) {
 	client := m.(*contentful.Contentful)
 	spaceID := d.Id()
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf(
+			"Space %s has deletion_protection enabled. Set deletion_protection = false and apply before destroying it",
+			spaceID,
+		)
+	}
+
 	space, err := client.Spaces.Get(spaceID)
 	if err != nil {
 		return err
@@ -103,6 +178,15 @@ func resourceSpaceDelete(d *schema.ResourceData, m interface{}) (err error) {
 	return err
 }
 
+func resourceSpaceImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	// Imported spaces are protected until the configuration says otherwise.
+	if err := d.Set("deletion_protection", true); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func updateSpaceProperties(d *schema.ResourceData, space *contentful.Space) error {
 	err := d.Set("version", space.Sys.Version)
 	if err != nil {
@@ -116,3 +200,46 @@ func updateSpaceProperties(d *schema.ResourceData, space *contentful.Space) erro
 
 	return nil
 }
+
+func getDefaultLocale(client *contentful.Contentful, spaceID string) (*locale, error) {
+	locales, err := listLocales(client, spaceID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, locale := range locales {
+		if locale.Default {
+			return locale, nil
+		}
+	}
+
+	return nil, errorLocaleNotFound
+}
+
+// updateDefaultLocale changes the code of the default locale of the space in
+// place. A space cannot be given a different default locale, so this is the
+// only way to change it without recreating the space.
+func updateDefaultLocale(client *contentful.Contentful, spaceID, code string) error {
+	defaultLocale, err := getDefaultLocale(client, spaceID)
+	if err != nil {
+		return err
+	}
+
+	if defaultLocale.Code == code {
+		return nil
+	}
+
+	err = checkLocaleNotFallback(client, spaceID, defaultLocale.Code)
+	if err != nil {
+		return err
+	}
+
+	locale, err := client.Locales.Get(spaceID, defaultLocale.Sys.ID)
+	if err != nil {
+		return err
+	}
+
+	locale.Code = code
+
+	return client.Locales.Upsert(spaceID, locale)
+}
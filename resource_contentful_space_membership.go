@@ -0,0 +1,234 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+func resourceContentfulSpaceMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreateSpaceMembership,
+		Read:   resourceReadSpaceMembership,
+		Update: resourceUpdateSpaceMembership,
+		Delete: resourceDeleteSpaceMembership,
+
+		Importer: &schema.ResourceImporter{
+			State: importSpaceScoped,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"version": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"space_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// The user must be a member of the organization, new users are
+			// invited with contentful_organization_membership first.
+			"email": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"user_id"},
+			},
+			"user_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"email"},
+			},
+			"admin": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"roles": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+type spaceMembership struct {
+	Sys   *entitySys `json:"sys,omitempty"`
+	Admin bool       `json:"admin"`
+	Roles []*link    `json:"roles"`
+	User  *link      `json:"user,omitempty"`
+}
+
+func spaceMembershipsPath(spaceID string) string {
+	return fmt.Sprintf("/spaces/%s/space_memberships", spaceID)
+}
+
+func spaceMembershipPath(spaceID, membershipID string) string {
+	return fmt.Sprintf("%s/%s", spaceMembershipsPath(spaceID), membershipID)
+}
+
+// organizationUserPath returns the path of a user of the organization.
+func organizationUserPath(client *contentful.Contentful, userID string) string {
+	return fmt.Sprintf("%s/users/%s", organizationPath(client), userID)
+}
+
+// findOrganizationUserID returns the ID of the organization member with the
+// email. Space memberships cannot invite users to the organization.
+func findOrganizationUserID(client *contentful.Contentful, email string) (string, error) {
+	members, err := listOrganizationMembers(client)
+	if err != nil {
+		return "", err
+	}
+
+	member := findOrganizationMember(members, email)
+	if member == nil || member.UserID == "" {
+		return "", fmt.Errorf(
+			"User %s is not a member of organization %s, invite them with contentful_organization_membership first",
+			email, organizationID(client),
+		)
+	}
+
+	return member.UserID, nil
+}
+
+func resourceCreateSpaceMembership(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+
+	membership := &spaceMembership{
+		Admin: d.Get("admin").(bool),
+		Roles: newLinks("Role", d.Get("roles").(*schema.Set).List()),
+	}
+
+	userID := d.Get("user_id").(string)
+
+	if email := d.Get("email").(string); email != "" {
+		if userID, err = findOrganizationUserID(client, email); err != nil {
+			return err
+		}
+	}
+
+	if userID == "" {
+		return fmt.Errorf("One of email or user_id must be set")
+	}
+
+	membership.User = newLink("User", userID)
+
+	err = cmaPost(client, spaceMembershipsPath(spaceID), membership, membership)
+	if err != nil {
+		return err
+	}
+
+	err = setSpaceMembershipProperties(d, membership)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(membership.Sys.ID)
+
+	return setSpaceMembershipEmail(d, client)
+}
+
+func resourceReadSpaceMembership(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+
+	membership := &spaceMembership{}
+
+	err = cmaGet(client, spaceMembershipPath(spaceID, d.Id()), nil, membership)
+	if isNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err = setSpaceMembershipProperties(d, membership); err != nil {
+		return err
+	}
+
+	return setSpaceMembershipEmail(d, client)
+}
+
+func resourceUpdateSpaceMembership(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+
+	membership := &spaceMembership{
+		Admin: d.Get("admin").(bool),
+		Roles: newLinks("Role", d.Get("roles").(*schema.Set).List()),
+	}
+
+	err = cmaPut(client, spaceMembershipPath(spaceID, d.Id()), d.Get("version").(int), membership, membership)
+	if err != nil {
+		return err
+	}
+
+	return setSpaceMembershipProperties(d, membership)
+}
+
+func resourceDeleteSpaceMembership(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+
+	err = cmaDelete(client, spaceMembershipPath(spaceID, d.Id()), 0, nil)
+	if isNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+func setSpaceMembershipProperties(d *schema.ResourceData, membership *spaceMembership) error {
+	if err := d.Set("version", membership.Sys.Version); err != nil {
+		return err
+	}
+
+	if err := d.Set("admin", membership.Admin); err != nil {
+		return err
+	}
+
+	if err := d.Set("roles", linkIDs(membership.Roles)); err != nil {
+		return err
+	}
+
+	user := membership.User
+	if user == nil {
+		user = membership.Sys.User
+	}
+
+	if user != nil {
+		if err := d.Set("user_id", user.Sys.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setSpaceMembershipEmail sets the email of the user of the membership, which
+// the space membership itself does not return.
+func setSpaceMembershipEmail(d *schema.ResourceData, client *contentful.Contentful) error {
+	user := &organizationUser{}
+
+	err := cmaGet(client, organizationUserPath(client, d.Get("user_id").(string)), nil, user)
+	if isNotFound(err) {
+		// The user is no longer a member of the organization.
+		return d.Set("email", "")
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return d.Set("email", user.Email)
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+func TestAccContentfulSpaceMembership_Basic(t *testing.T) {
+	email := os.Getenv("CONTENTFUL_TEST_USER_EMAIL")
+	if email == "" {
+		t.Skip("CONTENTFUL_TEST_USER_EMAIL must be set to an organization member for space membership tests")
+	}
+
+	spaceName := fmt.Sprintf("space-name-%s", acctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContentfulSpaceMembershipDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulSpaceMembershipConfig(spaceName, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"contentful_space_membership.mymembership", "admin", "true"),
+					resource.TestCheckResourceAttrSet(
+						"contentful_space_membership.mymembership", "user_id"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "contentful_space_membership.mymembership",
+				ImportState:       true,
+				ImportStateIdFunc: testAccSpaceScopedImportID("contentful_space_membership.mymembership"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestFindOrganizationUserID(t *testing.T) {
+	client, teardown := testCMAServer(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+  "total": 1,
+  "items": [
+    {"role": "member", "sys": {"id": "m1", "user": {"sys": {"id": "u1"}}}}
+  ],
+  "includes": {"User": [
+    {"email": "member@example.com", "sys": {"id": "u1"}}
+  ]}
+}`)
+	})
+	defer teardown()
+
+	userID, err := findOrganizationUserID(client, "Member@example.com")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if userID != "u1" {
+		t.Fatalf("expected user u1, got %s", userID)
+	}
+
+	_, err = findOrganizationUserID(client, "stranger@example.com")
+	if err == nil || !strings.Contains(err.Error(), "invite them with contentful_organization_membership") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// testAccSpaceScopedImportID returns the <space_id>/<id> import ID of a resource.
+func testAccSpaceScopedImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["space_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckContentfulSpaceMembershipDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*contentful.Contentful)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_space_membership" {
+			continue
+		}
+
+		spaceID := rs.Primary.Attributes["space_id"]
+
+		err := cmaGet(client, spaceMembershipPath(spaceID, rs.Primary.ID), nil, &spaceMembership{})
+		if err == nil {
+			return fmt.Errorf("Space membership still exists with id: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccContentfulSpaceMembershipConfig(spaceName, email string) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  deletion_protection = false
+}
+
+resource "contentful_space_membership" "mymembership" {
+  space_id = "${contentful_space.myspace.id}"
+
+  email = "%s"
+  admin = true
+}
+`, spaceName, email)
+}
@@ -1,7 +1,11 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -18,14 +22,34 @@ func TestAccContentfulSpace_Basic(t *testing.T) {
 		Steps: []resource.TestStep{
 			resource.TestStep{
 				Config: testAccContentfulSpaceConfig,
-				Check: resource.TestCheckResourceAttr(
-					"contentful_space.myspace", "name", "TF Acc Test Space"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"contentful_space.myspace", "name", "TF Acc Test Space"),
+					resource.TestCheckResourceAttr(
+						"contentful_space.myspace", "default_locale", "en"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "contentful_space.myspace",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imported spaces are always protected from deletion.
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			resource.TestStep{
 				Config: testAccContentfulSpaceUpdateConfig,
 				Check: resource.TestCheckResourceAttr(
 					"contentful_space.myspace", "name", "TF Acc Test Changed Space"),
 			},
+			resource.TestStep{
+				Config:      testAccContentfulSpaceDefaultLocaleConfig(false),
+				ExpectError: regexp.MustCompile("allow_default_locale_change"),
+			},
+			resource.TestStep{
+				Config: testAccContentfulSpaceDefaultLocaleConfig(true),
+				Check: resource.TestCheckResourceAttr(
+					"contentful_space.myspace", "default_locale", "en-US"),
+			},
 		},
 	})
 }
@@ -50,11 +74,52 @@ func testAccCheckContentfulSpaceDestroy(s *terraform.State) error {
 var testAccContentfulSpaceConfig = `
 resource "contentful_space" "myspace" {
   name = "TF Acc Test Space"
+  deletion_protection = false
 }
 `
 
 var testAccContentfulSpaceUpdateConfig = `
 resource "contentful_space" "myspace" {
   name = "TF Acc Test Changed Space"
+  deletion_protection = false
 }
 `
+
+func testAccContentfulSpaceDefaultLocaleConfig(allowChange bool) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "TF Acc Test Changed Space"
+  default_locale = "en-US"
+  allow_default_locale_change = %t
+  deletion_protection = false
+}
+`, allowChange)
+}
+
+func TestGetDefaultLocale(t *testing.T) {
+	client, teardown := testCMAServer(t, func(w http.ResponseWriter, r *http.Request) {
+		skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+
+		page := collection{Total: collectionPageSize + 1, Skip: skip}
+		for i := skip; i < skip+collectionPageSize && i < page.Total; i++ {
+			page.Items = append(page.Items, json.RawMessage(fmt.Sprintf(`{"code": "l%d"}`, i)))
+		}
+
+		// Only the locale on the second page is the default one.
+		if skip > 0 {
+			page.Items[0] = json.RawMessage(`{"code": "de-DE", "default": true, "sys": {"id": "default"}}`)
+		}
+
+		json.NewEncoder(w).Encode(page)
+	})
+	defer teardown()
+
+	locale, err := getDefaultLocale(client, "space")
+	if err != nil {
+		t.Fatalf("expected the default locale on the second page to be found, got %s", err)
+	}
+
+	if locale.Code != "de-DE" || locale.Sys.ID != "default" {
+		t.Fatalf("unexpected default locale: %#v", locale)
+	}
+}
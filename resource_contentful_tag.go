@@ -0,0 +1,232 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+// maxReportedTagReferences caps how many referencing entries and assets are
+// listed when a tag cannot be deleted.
+const maxReportedTagReferences = 20
+
+func resourceContentfulTag() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreateTag,
+		Read:   resourceReadTag,
+		Update: resourceUpdateTag,
+		Delete: resourceDeleteTag,
+
+		Importer: &schema.ResourceImporter{
+			State: importEnvironmentScoped,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"version": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"space_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "master",
+			},
+			"tag_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// Public tags are also exposed in the Content Delivery API.
+			"visibility": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "private",
+				ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+			},
+		},
+	}
+}
+
+type tag struct {
+	Sys  *tagSys `json:"sys"`
+	Name string  `json:"name"`
+}
+
+type tagSys struct {
+	entitySys
+	Visibility string `json:"visibility,omitempty"`
+}
+
+func tagPath(spaceID, environment, tagID string) string {
+	return fmt.Sprintf("%s/tags/%s", environmentPath(spaceID, environment), tagID)
+}
+
+func resourceCreateTag(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+	tagID := d.Get("tag_id").(string)
+
+	t := &tag{
+		Sys: &tagSys{
+			entitySys:  entitySys{ID: tagID, Type: "Tag"},
+			Visibility: d.Get("visibility").(string),
+		},
+		Name: d.Get("name").(string),
+	}
+
+	err = cmaPut(client, tagPath(spaceID, environment, tagID), 0, t, t)
+	if err != nil {
+		return err
+	}
+
+	err = setTagProperties(d, t)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(t.Sys.ID)
+
+	return nil
+}
+
+func resourceReadTag(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	t := &tag{}
+
+	err = cmaGet(client, tagPath(spaceID, environment, d.Id()), nil, t)
+	if isNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return setTagProperties(d, t)
+}
+
+func resourceUpdateTag(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	t := &tag{
+		Sys: &tagSys{
+			entitySys:  entitySys{ID: d.Id(), Type: "Tag"},
+			Visibility: d.Get("visibility").(string),
+		},
+		Name: d.Get("name").(string),
+	}
+
+	err = cmaPut(client, tagPath(spaceID, environment, d.Id()), d.Get("version").(int), t, t)
+	if err != nil {
+		return err
+	}
+
+	return setTagProperties(d, t)
+}
+
+func resourceDeleteTag(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	if err = checkTagNotReferenced(client, spaceID, environment, d.Id()); err != nil {
+		return err
+	}
+
+	err = cmaDelete(client, tagPath(spaceID, environment, d.Id()), d.Get("version").(int), nil)
+	if isNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// checkTagNotReferenced fails with the entries and assets that still use the
+// tag, so they can be untagged before the tag is deleted.
+func checkTagNotReferenced(client *contentful.Contentful, spaceID, environment, tagID string) error {
+	query := url.Values{}
+	query.Set("metadata.tags.sys.id[in]", tagID)
+
+	var references []string
+
+	for _, kind := range []string{"entries", "assets"} {
+		path := fmt.Sprintf("%s/%s", environmentPath(spaceID, environment), kind)
+
+		total, err := cmaCount(client, path, query)
+		if err != nil {
+			return err
+		}
+
+		if total == 0 {
+			continue
+		}
+
+		var ids []string
+		err = cmaList(client, path, query, maxReportedTagReferences, func(item json.RawMessage) error {
+			var entity struct {
+				Sys entitySys `json:"sys"`
+			}
+
+			if err := json.Unmarshal(item, &entity); err != nil {
+				return err
+			}
+
+			ids = append(ids, entity.Sys.ID)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if total > len(ids) {
+			ids = append(ids, fmt.Sprintf("and %d more", total-len(ids)))
+		}
+
+		references = append(references, fmt.Sprintf("%d %s (%s)", total, kind, strings.Join(ids, ", ")))
+	}
+
+	if len(references) > 0 {
+		return fmt.Errorf("Tag %s is still referenced by %s", tagID, strings.Join(references, " and "))
+	}
+
+	return nil
+}
+
+func setTagProperties(d *schema.ResourceData, t *tag) error {
+	if err := d.Set("version", t.Sys.Version); err != nil {
+		return err
+	}
+
+	if err := d.Set("tag_id", t.Sys.ID); err != nil {
+		return err
+	}
+
+	if err := d.Set("name", t.Name); err != nil {
+		return err
+	}
+
+	return d.Set("visibility", t.Sys.Visibility)
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+func TestAccContentfulTag_Basic(t *testing.T) {
+	spaceName := fmt.Sprintf("space-name-%s", acctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContentfulTagDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulTagConfig(spaceName, "Campaign", `"${contentful_tag.mytag.tag_id}"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_tag.mytag", "name", "Campaign"),
+					resource.TestCheckResourceAttr("contentful_tag.mytag", "visibility", "public"),
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "tags.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccContentfulTagConfig(spaceName, "Summer campaign", `"${contentful_tag.mytag.tag_id}"`),
+				Check: resource.TestCheckResourceAttr(
+					"contentful_tag.mytag", "name", "Summer campaign"),
+			},
+			// Only the tags of the entry change, the plan must be empty
+			// afterwards.
+			resource.TestStep{
+				Config: testAccContentfulTagConfig(
+					spaceName, "Summer campaign",
+					`"${contentful_tag.mytag.tag_id}", "${contentful_tag.othertag.tag_id}"`,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "tags.#", "2"),
+					testAccCheckContentfulEntryTags("contentful_entry.myentry", "campaign", "newsletter"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "contentful_tag.mytag",
+				ImportState:       true,
+				ImportStateIdFunc: testAccSpaceScopedImportID("contentful_tag.mytag"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestCheckTagNotReferenced(t *testing.T) {
+	client, teardown := testCMAServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("metadata.tags.sys.id[in]") != "campaign" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+
+		if strings.HasSuffix(r.URL.Path, "/assets") {
+			fmt.Fprint(w, `{"total": 0, "items": []}`)
+			return
+		}
+
+		fmt.Fprint(w, `{"total": 2, "items": [{"sys": {"id": "home"}}, {"sys": {"id": "about"}}]}`)
+	})
+	defer teardown()
+
+	err := checkTagNotReferenced(client, "space", "master", "campaign")
+	if err == nil {
+		t.Fatal("expected an error for a referenced tag")
+	}
+
+	if !strings.Contains(err.Error(), "2 entries (home, about)") {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func testAccCheckContentfulTagDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*contentful.Contentful)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_tag" {
+			continue
+		}
+
+		spaceID := rs.Primary.Attributes["space_id"]
+		environment := rs.Primary.Attributes["environment"]
+
+		err := cmaGet(client, tagPath(spaceID, environment, rs.Primary.ID), nil, &tag{})
+		if err == nil {
+			return fmt.Errorf("Tag still exists with id: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+// testAccCheckContentfulEntryTags checks the tags of the entry in Contentful.
+func testAccCheckContentfulEntryTags(resourceName string, tagIDs ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*contentful.Contentful)
+		spaceID := rs.Primary.Attributes["space_id"]
+		environment := rs.Primary.Attributes["environment"]
+
+		e := &entry{}
+		if err := cmaGet(client, entryPath(spaceID, environment, rs.Primary.ID), nil, e); err != nil {
+			return err
+		}
+
+		actual := metadataTagIDs(e.Metadata)
+		sort.Strings(actual)
+
+		if !reflect.DeepEqual(actual, tagIDs) {
+			return fmt.Errorf("Expected tags %v on entry %s, got %v", tagIDs, rs.Primary.ID, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccContentfulTagConfig(spaceName, name, entryTags string) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  default_locale = "en-US"
+  deletion_protection = false
+}
+
+resource "contentful_tag" "mytag" {
+  space_id = "${contentful_space.myspace.id}"
+
+  tag_id = "campaign"
+  name = "%s"
+  visibility = "public"
+}
+
+resource "contentful_tag" "othertag" {
+  space_id = "${contentful_space.myspace.id}"
+
+  tag_id = "newsletter"
+  name = "Newsletter"
+}
+
+resource "contentful_contenttype" "page" {
+  space_id = "${contentful_space.myspace.id}"
+
+  name = "Page"
+  display_field = "title"
+  delete_entries_on_destroy = true
+
+  field {
+    id = "title"
+    name = "Title"
+    type = "Symbol"
+  }
+}
+
+resource "contentful_entry" "myentry" {
+  space_id = "${contentful_space.myspace.id}"
+  content_type_id = "${contentful_contenttype.page.id}"
+
+  field {
+    id = "title"
+    locale = "en-US"
+    content = "\"Landing page\""
+  }
+
+  tags = [%s]
+}
+`, spaceName, name, entryTags)
+}
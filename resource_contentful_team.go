@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+func resourceContentfulTeam() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreateTeam,
+		Read:   resourceReadTeam,
+		Update: resourceUpdateTeam,
+		Delete: resourceDeleteTeam,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"version": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+type team struct {
+	Sys         *entitySys `json:"sys,omitempty"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+}
+
+func teamsPath(client *contentful.Contentful) string {
+	return organizationPath(client) + "/teams"
+}
+
+func teamPath(client *contentful.Contentful, teamID string) string {
+	return fmt.Sprintf("%s/%s", teamsPath(client), teamID)
+}
+
+func resourceCreateTeam(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+
+	t := &team{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	err = cmaPost(client, teamsPath(client), t, t)
+	if err != nil {
+		return err
+	}
+
+	err = setTeamProperties(d, t)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(t.Sys.ID)
+
+	return nil
+}
+
+func resourceReadTeam(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+
+	t := &team{}
+
+	err = cmaGet(client, teamPath(client, d.Id()), nil, t)
+	if isNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return setTeamProperties(d, t)
+}
+
+func resourceUpdateTeam(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+
+	t := &team{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	err = cmaPut(client, teamPath(client, d.Id()), d.Get("version").(int), t, t)
+	if err != nil {
+		return err
+	}
+
+	return setTeamProperties(d, t)
+}
+
+func resourceDeleteTeam(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+
+	err = cmaDelete(client, teamPath(client, d.Id()), d.Get("version").(int), nil)
+	if isNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+func setTeamProperties(d *schema.ResourceData, t *team) error {
+	if err := d.Set("version", t.Sys.Version); err != nil {
+		return err
+	}
+
+	if err := d.Set("name", t.Name); err != nil {
+		return err
+	}
+
+	if err := d.Set("description", t.Description); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+func resourceContentfulTeamMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreateTeamMembership,
+		Read:   resourceReadTeamMembership,
+		Delete: resourceDeleteTeamMembership,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceTeamMembershipImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"team_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"organization_membership_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+type teamMembership struct {
+	Sys                      *teamMembershipSys `json:"sys,omitempty"`
+	OrganizationMembershipID string             `json:"organizationMembershipId,omitempty"`
+}
+
+type teamMembershipSys struct {
+	entitySys
+	Team                   *link `json:"team,omitempty"`
+	OrganizationMembership *link `json:"organizationMembership,omitempty"`
+}
+
+func teamMembershipsPath(client *contentful.Contentful, teamID string) string {
+	return teamPath(client, teamID) + "/team_memberships"
+}
+
+func teamMembershipPath(client *contentful.Contentful, teamID, membershipID string) string {
+	return fmt.Sprintf("%s/%s", teamMembershipsPath(client, teamID), membershipID)
+}
+
+func resourceCreateTeamMembership(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	teamID := d.Get("team_id").(string)
+
+	membership := &teamMembership{
+		OrganizationMembershipID: d.Get("organization_membership_id").(string),
+	}
+
+	err = cmaPost(client, teamMembershipsPath(client, teamID), membership, membership)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(membership.Sys.ID)
+
+	return nil
+}
+
+func resourceReadTeamMembership(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	teamID := d.Get("team_id").(string)
+
+	membership := &teamMembership{}
+
+	err = cmaGet(client, teamMembershipPath(client, teamID, d.Id()), nil, membership)
+	if isNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if membership.Sys.OrganizationMembership != nil {
+		return d.Set("organization_membership_id", membership.Sys.OrganizationMembership.Sys.ID)
+	}
+
+	return nil
+}
+
+func resourceDeleteTeamMembership(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	teamID := d.Get("team_id").(string)
+
+	err = cmaDelete(client, teamMembershipPath(client, teamID, d.Id()), 0, nil)
+	if isNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// resourceTeamMembershipImport imports team memberships by an ID of the form
+// <team_id>/<team_membership_id>.
+func resourceTeamMembershipImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Unexpected import ID %q, expected <team_id>/<team_membership_id>", d.Id())
+	}
+
+	if err := d.Set("team_id", parts[0]); err != nil {
+		return nil, err
+	}
+
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
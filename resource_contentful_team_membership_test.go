@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+func TestAccContentfulTeamMembership_Basic(t *testing.T) {
+	organizationMembershipID := os.Getenv("CONTENTFUL_TEST_ORGANIZATION_MEMBERSHIP_ID")
+	if organizationMembershipID == "" {
+		t.Skip("CONTENTFUL_TEST_ORGANIZATION_MEMBERSHIP_ID must be set for team membership tests")
+	}
+
+	teamName := fmt.Sprintf("team-name-%s", acctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContentfulTeamMembershipDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulTeamMembershipConfig(teamName, organizationMembershipID),
+				Check: resource.TestCheckResourceAttr(
+					"contentful_team_membership.mymembership", "organization_membership_id", organizationMembershipID),
+			},
+			resource.TestStep{
+				ResourceName: "contentful_team_membership.mymembership",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["contentful_team_membership.mymembership"]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["team_id"], rs.Primary.ID), nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckContentfulTeamMembershipDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*contentful.Contentful)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_team_membership" {
+			continue
+		}
+
+		teamID := rs.Primary.Attributes["team_id"]
+
+		err := cmaGet(client, teamMembershipPath(client, teamID, rs.Primary.ID), nil, &teamMembership{})
+		if err == nil {
+			return fmt.Errorf("Team membership still exists with id: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccContentfulTeamMembershipConfig(teamName, organizationMembershipID string) string {
+	return fmt.Sprintf(`
+resource "contentful_team" "myteam" {
+  name = "%s"
+}
+
+resource "contentful_team_membership" "mymembership" {
+  team_id = "${contentful_team.myteam.id}"
+  organization_membership_id = "%s"
+}
+`, teamName, organizationMembershipID)
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+func resourceContentfulTeamSpaceMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreateTeamSpaceMembership,
+		Read:   resourceReadTeamSpaceMembership,
+		Update: resourceUpdateTeamSpaceMembership,
+		Delete: resourceDeleteTeamSpaceMembership,
+
+		Importer: &schema.ResourceImporter{
+			State: importSpaceScoped,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"version": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"space_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"team_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"admin": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"roles": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+type teamSpaceMembership struct {
+	Sys   *teamSpaceMembershipSys `json:"sys,omitempty"`
+	Admin bool                    `json:"admin"`
+	Roles []*link                 `json:"roles"`
+}
+
+type teamSpaceMembershipSys struct {
+	entitySys
+	Team *link `json:"team,omitempty"`
+}
+
+func teamSpaceMembershipsPath(spaceID string) string {
+	return fmt.Sprintf("/spaces/%s/team_space_memberships", spaceID)
+}
+
+func teamSpaceMembershipPath(spaceID, membershipID string) string {
+	return fmt.Sprintf("%s/%s", teamSpaceMembershipsPath(spaceID), membershipID)
+}
+
+// saveTeamSpaceMembership creates or updates a team space membership. The
+// team is passed in a header rather than in the payload.
+func saveTeamSpaceMembership(client *contentful.Contentful, method, path, teamID string, version int, membership *teamSpaceMembership) error {
+	req, err := newCMARequest(client, method, path, nil, membership)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Team", teamID)
+
+	if version > 0 {
+		setVersion(req, version)
+	}
+
+	return doCMARequest(client, req, membership)
+}
+
+func resourceCreateTeamSpaceMembership(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+
+	membership := &teamSpaceMembership{
+		Admin: d.Get("admin").(bool),
+		Roles: newLinks("Role", d.Get("roles").(*schema.Set).List()),
+	}
+
+	err = saveTeamSpaceMembership(client, http.MethodPost, teamSpaceMembershipsPath(spaceID), d.Get("team_id").(string), 0, membership)
+	if err != nil {
+		return err
+	}
+
+	err = setTeamSpaceMembershipProperties(d, membership)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(membership.Sys.ID)
+
+	return nil
+}
+
+func resourceReadTeamSpaceMembership(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+
+	membership := &teamSpaceMembership{}
+
+	err = cmaGet(client, teamSpaceMembershipPath(spaceID, d.Id()), nil, membership)
+	if isNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return setTeamSpaceMembershipProperties(d, membership)
+}
+
+func resourceUpdateTeamSpaceMembership(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+
+	membership := &teamSpaceMembership{
+		Admin: d.Get("admin").(bool),
+		Roles: newLinks("Role", d.Get("roles").(*schema.Set).List()),
+	}
+
+	err = saveTeamSpaceMembership(client, http.MethodPut, teamSpaceMembershipPath(spaceID, d.Id()), d.Get("team_id").(string), d.Get("version").(int), membership)
+	if err != nil {
+		return err
+	}
+
+	return setTeamSpaceMembershipProperties(d, membership)
+}
+
+func resourceDeleteTeamSpaceMembership(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+
+	err = cmaDelete(client, teamSpaceMembershipPath(spaceID, d.Id()), 0, nil)
+	if isNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+func setTeamSpaceMembershipProperties(d *schema.ResourceData, membership *teamSpaceMembership) error {
+	if err := d.Set("version", membership.Sys.Version); err != nil {
+		return err
+	}
+
+	if membership.Sys.Team != nil {
+		if err := d.Set("team_id", membership.Sys.Team.Sys.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("admin", membership.Admin); err != nil {
+		return err
+	}
+
+	if err := d.Set("roles", linkIDs(membership.Roles)); err != nil {
+		return err
+	}
+
+	return nil
+}
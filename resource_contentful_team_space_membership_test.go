@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+func TestAccContentfulTeamSpaceMembership_Basic(t *testing.T) {
+	spaceName := fmt.Sprintf("space-name-%s", acctest.RandString(3))
+	teamName := fmt.Sprintf("team-name-%s", acctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContentfulTeamSpaceMembershipDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulTeamSpaceMembershipConfig(spaceName, teamName, true),
+				Check: resource.TestCheckResourceAttr(
+					"contentful_team_space_membership.mymembership", "admin", "true"),
+			},
+			resource.TestStep{
+				Config: testAccContentfulTeamSpaceMembershipConfig(spaceName, teamName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"contentful_team_space_membership.mymembership", "admin", "false"),
+					resource.TestCheckResourceAttr(
+						"contentful_team_space_membership.mymembership", "roles.#", "1"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "contentful_team_space_membership.mymembership",
+				ImportState:       true,
+				ImportStateIdFunc: testAccSpaceScopedImportID("contentful_team_space_membership.mymembership"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckContentfulTeamSpaceMembershipDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*contentful.Contentful)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_team_space_membership" {
+			continue
+		}
+
+		spaceID := rs.Primary.Attributes["space_id"]
+
+		err := cmaGet(client, teamSpaceMembershipPath(spaceID, rs.Primary.ID), nil, &teamSpaceMembership{})
+		if err == nil {
+			return fmt.Errorf("Team space membership still exists with id: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccContentfulTeamSpaceMembershipConfig(spaceName, teamName string, admin bool) string {
+	roles := `["${contentful_role.myrole.id}"]`
+	if admin {
+		roles = "[]"
+	}
+
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  deletion_protection = false
+}
+
+resource "contentful_role" "myrole" {
+  space_id = "${contentful_space.myspace.id}"
+
+  name = "Reader"
+
+  policy {
+    effect = "allow"
+    actions = ["read"]
+    constraint = "{\"equals\": [{\"doc\": \"sys.type\"}, \"Entry\"]}"
+  }
+}
+
+resource "contentful_team" "myteam" {
+  name = "%s"
+}
+
+resource "contentful_team_space_membership" "mymembership" {
+  space_id = "${contentful_space.myspace.id}"
+  team_id = "${contentful_team.myteam.id}"
+
+  admin = %t
+  roles = %s
+}
+`, spaceName, teamName, admin, roles)
+}
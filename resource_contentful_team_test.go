@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+func TestAccContentfulTeam_Basic(t *testing.T) {
+	name := fmt.Sprintf("team-name-%s", acctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContentfulTeamDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulTeamConfig(name, "Editors"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_team.myteam", "name", name),
+					resource.TestCheckResourceAttr("contentful_team.myteam", "description", "Editors"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccContentfulTeamConfig(name, "Editors and reviewers"),
+				Check: resource.TestCheckResourceAttr(
+					"contentful_team.myteam", "description", "Editors and reviewers"),
+			},
+			resource.TestStep{
+				ResourceName:      "contentful_team.myteam",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckContentfulTeamDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*contentful.Contentful)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_team" {
+			continue
+		}
+
+		err := cmaGet(client, teamPath(client, rs.Primary.ID), nil, &team{})
+		if err == nil {
+			return fmt.Errorf("Team still exists with id: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccContentfulTeamConfig(name, description string) string {
+	return fmt.Sprintf(`
+resource "contentful_team" "myteam" {
+  name = "%s"
+  description = "%s"
+}
+`, name, description)
+}
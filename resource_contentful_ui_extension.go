@@ -0,0 +1,563 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+// maxExtensionSrcdocSize is the largest srcdoc Contentful accepts for a UI
+// extension. Bigger extensions have to be hosted and referenced with src.
+const maxExtensionSrcdocSize = 200 * 1024
+
+// extensionFieldTypes maps the field types of UI extensions, in the notation
+// of the Contentful CLI, to the field definitions they stand for.
+var extensionFieldTypes = map[string]map[string]interface{}{
+	"Symbol":   {"type": "Symbol"},
+	"Symbols":  {"type": "Array", "items": map[string]interface{}{"type": "Symbol"}},
+	"Text":     {"type": "Text"},
+	"RichText": {"type": "RichText"},
+	"Integer":  {"type": "Integer"},
+	"Number":   {"type": "Number"},
+	"Date":     {"type": "Date"},
+	"Boolean":  {"type": "Boolean"},
+	"Object":   {"type": "Object"},
+	"Location": {"type": "Location"},
+	"Entry":    {"type": "Link", "linkType": "Entry"},
+	"Entries":  {"type": "Array", "items": map[string]interface{}{"type": "Link", "linkType": "Entry"}},
+	"Asset":    {"type": "Link", "linkType": "Asset"},
+	"Assets":   {"type": "Array", "items": map[string]interface{}{"type": "Link", "linkType": "Asset"}},
+}
+
+var extensionParameterTypes = []string{"Symbol", "Enum", "Number", "Boolean"}
+
+func resourceContentfulUIExtension() *schema.Resource {
+	var fieldTypes []string
+	for fieldType := range extensionFieldTypes {
+		fieldTypes = append(fieldTypes, fieldType)
+	}
+	sort.Strings(fieldTypes)
+
+	return &schema.Resource{
+		Create: resourceCreateUIExtension,
+		Read:   resourceReadUIExtension,
+		Update: resourceUpdateUIExtension,
+		Delete: resourceDeleteUIExtension,
+
+		CustomizeDiff: resourceUIExtensionCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			State: importEnvironmentScoped,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"version": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"space_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "master",
+			},
+			// The ID is generated by Contentful unless it is given here.
+			"extension_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// URL the extension is loaded from.
+			"src": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"srcdoc_path"},
+			},
+			// Path of a local HTML file that is uploaded as the extension.
+			"srcdoc_path": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"src"},
+			},
+			// SHA-256 of the srcdoc, a change of the local file triggers an
+			// update.
+			"srcdoc_hash": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// Types of the fields the extension can be used for, e.g. Symbol,
+			// Symbols, Entry or Assets. Sidebar extensions need none.
+			"field_types": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(fieldTypes, false),
+				},
+			},
+			// Renders the extension in the sidebar of the entry editor.
+			"sidebar": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			// Parameters configured once per installation of the extension
+			// in the environment.
+			"installation_parameter": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(extensionParameterTypes, false),
+						},
+						"required": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						// The default as a string, e.g. "10" for a Number or
+						// "true" for a Boolean.
+						"default": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						// The values of an Enum.
+						"options": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type uiExtension struct {
+	Sys       *entitySys            `json:"sys,omitempty"`
+	Extension uiExtensionDefinition `json:"extension"`
+}
+
+type uiExtensionDefinition struct {
+	Name       string                   `json:"name"`
+	Src        string                   `json:"src,omitempty"`
+	Srcdoc     string                   `json:"srcdoc,omitempty"`
+	FieldTypes []map[string]interface{} `json:"fieldTypes"`
+	Sidebar    bool                     `json:"sidebar"`
+	Parameters *uiExtensionParameters   `json:"parameters,omitempty"`
+}
+
+type uiExtensionParameters struct {
+	Installation []*uiExtensionParameter `json:"installation,omitempty"`
+}
+
+type uiExtensionParameter struct {
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	Type        string      `json:"type"`
+	Required    bool        `json:"required"`
+	Default     interface{} `json:"default,omitempty"`
+	Options     []string    `json:"options,omitempty"`
+}
+
+func uiExtensionsPath(spaceID, environment string) string {
+	return environmentPath(spaceID, environment) + "/extensions"
+}
+
+func uiExtensionPath(spaceID, environment, extensionID string) string {
+	return fmt.Sprintf("%s/%s", uiExtensionsPath(spaceID, environment), extensionID)
+}
+
+// readExtensionSrcdoc reads the local HTML file of an extension and returns it
+// with its hash. Files over the size limit of Contentful are rejected.
+func readExtensionSrcdoc(path string) (srcdoc, hash string, err error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", "", err
+	}
+
+	if len(data) > maxExtensionSrcdocSize {
+		return "", "", fmt.Errorf(
+			"%s has %d bytes, more than the %d bytes allowed for srcdoc. Host the extension and use src instead",
+			path, len(data), maxExtensionSrcdocSize,
+		)
+	}
+
+	return string(data), srcdocSHA256(string(data)), nil
+}
+
+func srcdocSHA256(srcdoc string) string {
+	sum := sha256.Sum256([]byte(srcdoc))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// resourceUIExtensionCustomizeDiff checks the source of the extension and its
+// parameters, and hashes the local srcdoc so changing its content plans an
+// update even though the path stays the same.
+func resourceUIExtensionCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	for _, rawParameter := range d.Get("installation_parameter").([]interface{}) {
+		parameter := rawParameter.(map[string]interface{})
+
+		if err := checkExtensionParameter(parameter); err != nil {
+			return err
+		}
+	}
+
+	if !d.NewValueKnown("src") || !d.NewValueKnown("srcdoc_path") {
+		return nil
+	}
+
+	path := d.Get("srcdoc_path").(string)
+	if path == "" {
+		if d.Get("src").(string) == "" {
+			return fmt.Errorf("One of src or srcdoc_path must be set")
+		}
+
+		if d.Get("srcdoc_hash").(string) != "" {
+			return d.SetNew("srcdoc_hash", "")
+		}
+
+		return nil
+	}
+
+	_, hash, err := readExtensionSrcdoc(path)
+	if err != nil {
+		return fmt.Errorf("Reading the srcdoc of the extension: %s", err)
+	}
+
+	if hash != d.Get("srcdoc_hash").(string) {
+		return d.SetNew("srcdoc_hash", hash)
+	}
+
+	return nil
+}
+
+// checkExtensionParameter validates an installation parameter beyond what the
+// schema can express.
+func checkExtensionParameter(parameter map[string]interface{}) error {
+	id := parameter["id"].(string)
+	parameterType := parameter["type"].(string)
+	options := parameter["options"].([]interface{})
+
+	if parameterType == "Enum" && len(options) == 0 {
+		return fmt.Errorf("installation_parameter %s of type Enum needs options", id)
+	}
+
+	if parameterType != "Enum" && len(options) > 0 {
+		return fmt.Errorf("options of installation_parameter %s are only supported for type Enum", id)
+	}
+
+	value, err := expandExtensionParameterDefault(parameterType, parameter["default"].(string))
+	if err != nil {
+		return fmt.Errorf("invalid default of installation_parameter %s: %s", id, err)
+	}
+
+	// The default is read back the way the API formats it.
+	if canonical := flattenExtensionParameterDefault(value); canonical != parameter["default"].(string) {
+		return fmt.Errorf("default of installation_parameter %s must be written as %q", id, canonical)
+	}
+
+	if value := parameter["default"].(string); parameterType == "Enum" && value != "" {
+		for _, option := range options {
+			if option.(string) == value {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("default of installation_parameter %s is not one of its options", id)
+	}
+
+	return nil
+}
+
+// expandExtensionParameterDefault converts the default of a parameter to the
+// JSON type of the parameter.
+func expandExtensionParameterDefault(parameterType, value string) (interface{}, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	switch parameterType {
+	case "Number":
+		return strconv.ParseFloat(value, 64)
+	case "Boolean":
+		return strconv.ParseBool(value)
+	}
+
+	return value, nil
+}
+
+func flattenExtensionParameterDefault(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+
+	return fmt.Sprint(value)
+}
+
+func expandExtensionFieldTypes(rawFieldTypes []interface{}) []map[string]interface{} {
+	fieldTypes := []map[string]interface{}{}
+
+	for _, fieldType := range rawFieldTypes {
+		fieldTypes = append(fieldTypes, extensionFieldTypes[fieldType.(string)])
+	}
+
+	return fieldTypes
+}
+
+// flattenExtensionFieldTypes converts field definitions back into the
+// notation of field_types. Definitions without a name are left out.
+func flattenExtensionFieldTypes(fieldTypes []map[string]interface{}) []interface{} {
+	flattened := []interface{}{}
+
+	for _, fieldType := range fieldTypes {
+		for name, definition := range extensionFieldTypes {
+			if fieldTypeMatches(definition, fieldType) {
+				flattened = append(flattened, name)
+				break
+			}
+		}
+	}
+
+	return flattened
+}
+
+func fieldTypeMatches(definition, fieldType map[string]interface{}) bool {
+	if definition["type"] != fieldType["type"] || definition["linkType"] != fieldType["linkType"] {
+		return false
+	}
+
+	definitionItems, _ := definition["items"].(map[string]interface{})
+	items, _ := fieldType["items"].(map[string]interface{})
+
+	if definitionItems == nil || items == nil {
+		return definitionItems == nil && items == nil
+	}
+
+	return fieldTypeMatches(definitionItems, items)
+}
+
+func buildUIExtension(d *schema.ResourceData) (*uiExtension, error) {
+	e := &uiExtension{
+		Extension: uiExtensionDefinition{
+			Name:       d.Get("name").(string),
+			Src:        d.Get("src").(string),
+			FieldTypes: expandExtensionFieldTypes(d.Get("field_types").([]interface{})),
+			Sidebar:    d.Get("sidebar").(bool),
+		},
+	}
+
+	if path := d.Get("srcdoc_path").(string); path != "" {
+		srcdoc, hash, err := readExtensionSrcdoc(path)
+		if err != nil {
+			return nil, err
+		}
+
+		e.Extension.Srcdoc = srcdoc
+
+		if err = d.Set("srcdoc_hash", hash); err != nil {
+			return nil, err
+		}
+	}
+
+	var parameters []*uiExtensionParameter
+	for _, rawParameter := range d.Get("installation_parameter").([]interface{}) {
+		parameter := rawParameter.(map[string]interface{})
+
+		defaultValue, err := expandExtensionParameterDefault(parameter["type"].(string), parameter["default"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		var options []string
+		for _, option := range parameter["options"].([]interface{}) {
+			options = append(options, option.(string))
+		}
+
+		parameters = append(parameters, &uiExtensionParameter{
+			ID:          parameter["id"].(string),
+			Name:        parameter["name"].(string),
+			Description: parameter["description"].(string),
+			Type:        parameter["type"].(string),
+			Required:    parameter["required"].(bool),
+			Default:     defaultValue,
+			Options:     options,
+		})
+	}
+
+	if len(parameters) > 0 {
+		e.Extension.Parameters = &uiExtensionParameters{Installation: parameters}
+	}
+
+	return e, nil
+}
+
+func resourceCreateUIExtension(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	e, err := buildUIExtension(d)
+	if err != nil {
+		return err
+	}
+
+	if extensionID := d.Get("extension_id").(string); extensionID != "" {
+		err = cmaPut(client, uiExtensionPath(spaceID, environment, extensionID), 0, e, e)
+	} else {
+		err = cmaPost(client, uiExtensionsPath(spaceID, environment), e, e)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(e.Sys.ID)
+
+	return setUIExtensionProperties(d, e)
+}
+
+func resourceReadUIExtension(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	e := &uiExtension{}
+
+	err = cmaGet(client, uiExtensionPath(spaceID, environment, d.Id()), nil, e)
+	if isNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return setUIExtensionProperties(d, e)
+}
+
+func resourceUpdateUIExtension(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	e, err := buildUIExtension(d)
+	if err != nil {
+		return err
+	}
+
+	err = cmaPut(client, uiExtensionPath(spaceID, environment, d.Id()), d.Get("version").(int), e, e)
+	if err != nil {
+		return err
+	}
+
+	return setUIExtensionProperties(d, e)
+}
+
+func resourceDeleteUIExtension(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Contentful)
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	err = cmaDelete(client, uiExtensionPath(spaceID, environment, d.Id()), d.Get("version").(int), nil)
+	if isNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// setUIExtensionProperties sets the state from the extension. The srcdoc
+// itself is not kept in the state, only its hash.
+func setUIExtensionProperties(d *schema.ResourceData, e *uiExtension) (err error) {
+	if err = d.Set("version", e.Sys.Version); err != nil {
+		return err
+	}
+
+	if err = d.Set("extension_id", e.Sys.ID); err != nil {
+		return err
+	}
+
+	if err = d.Set("name", e.Extension.Name); err != nil {
+		return err
+	}
+
+	if err = d.Set("src", e.Extension.Src); err != nil {
+		return err
+	}
+
+	srcdocHash := ""
+	if e.Extension.Srcdoc != "" {
+		srcdocHash = srcdocSHA256(e.Extension.Srcdoc)
+	}
+
+	if err = d.Set("srcdoc_hash", srcdocHash); err != nil {
+		return err
+	}
+
+	if err = d.Set("field_types", flattenExtensionFieldTypes(e.Extension.FieldTypes)); err != nil {
+		return err
+	}
+
+	if err = d.Set("sidebar", e.Extension.Sidebar); err != nil {
+		return err
+	}
+
+	parameters := []interface{}{}
+	if e.Extension.Parameters != nil {
+		for _, parameter := range e.Extension.Parameters.Installation {
+			parameters = append(parameters, map[string]interface{}{
+				"id":          parameter.ID,
+				"name":        parameter.Name,
+				"description": parameter.Description,
+				"type":        parameter.Type,
+				"required":    parameter.Required,
+				"default":     flattenExtensionParameterDefault(parameter.Default),
+				"options":     parameter.Options,
+			})
+		}
+	}
+
+	return d.Set("installation_parameter", parameters)
+}
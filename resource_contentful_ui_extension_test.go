@@ -0,0 +1,219 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	contentful "github.com/tolgaakyuz/contentful-go"
+)
+
+func TestAccContentfulUIExtension_Basic(t *testing.T) {
+	spaceName := fmt.Sprintf("space-name-%s", acctest.RandString(3))
+
+	file, err := ioutil.TempFile("", "contentful-extension")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	file.Close()
+	defer os.Remove(file.Name())
+
+	writeFile := func(content string) func() {
+		return func() {
+			if err := ioutil.WriteFile(file.Name(), []byte(content), 0644); err != nil {
+				t.Fatalf("err: %s", err)
+			}
+		}
+	}
+	writeFile("<html><body>first version</body></html>")()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContentfulUIExtensionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContentfulUIExtensionConfig(spaceName, file.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_ui_extension.hosted", "field_types.#", "2"),
+					resource.TestCheckResourceAttr("contentful_ui_extension.hosted", "field_types.1", "Entries"),
+					resource.TestCheckResourceAttr("contentful_ui_extension.hosted", "installation_parameter.0.default", "10"),
+					resource.TestCheckResourceAttr("contentful_ui_extension.local", "sidebar", "true"),
+					resource.TestCheckResourceAttr(
+						"contentful_ui_extension.local", "srcdoc_hash",
+						srcdocSHA256("<html><body>first version</body></html>")),
+				),
+			},
+			resource.TestStep{
+				PreConfig: writeFile("<html><body>second version</body></html>"),
+				Config:    testAccContentfulUIExtensionConfig(spaceName, file.Name()),
+				Check: resource.TestCheckResourceAttr(
+					"contentful_ui_extension.local", "srcdoc_hash",
+					srcdocSHA256("<html><body>second version</body></html>")),
+			},
+			resource.TestStep{
+				ResourceName:      "contentful_ui_extension.hosted",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["contentful_ui_extension.hosted"]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["space_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func TestReadExtensionSrcdoc(t *testing.T) {
+	file, err := ioutil.TempFile("", "contentful-extension")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err = file.WriteString(strings.Repeat("x", maxExtensionSrcdocSize+1)); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	file.Close()
+
+	if _, _, err = readExtensionSrcdoc(file.Name()); err == nil || !strings.Contains(err.Error(), "use src instead") {
+		t.Fatalf("expected the size limit to be enforced, got %v", err)
+	}
+
+	if err = ioutil.WriteFile(file.Name(), []byte("hello"), 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	srcdoc, hash, err := readExtensionSrcdoc(file.Name())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if srcdoc != "hello" || hash != "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824" {
+		t.Fatalf("unexpected srcdoc %q with hash %s", srcdoc, hash)
+	}
+}
+
+func TestExtensionFieldTypes(t *testing.T) {
+	fieldTypes := []interface{}{"Symbol", "Symbols", "Entry", "Assets"}
+
+	flattened := flattenExtensionFieldTypes(expandExtensionFieldTypes(fieldTypes))
+	if !reflect.DeepEqual(flattened, fieldTypes) {
+		t.Fatalf("expected %v, got %v", fieldTypes, flattened)
+	}
+}
+
+func TestCheckExtensionParameter(t *testing.T) {
+	cases := []struct {
+		parameter map[string]interface{}
+		err       string
+	}{
+		{
+			parameter: map[string]interface{}{"id": "size", "type": "Number", "default": "10", "options": []interface{}{}},
+		},
+		{
+			parameter: map[string]interface{}{"id": "size", "type": "Number", "default": "ten", "options": []interface{}{}},
+			err:       "invalid default",
+		},
+		{
+			parameter: map[string]interface{}{"id": "size", "type": "Number", "default": "10.0", "options": []interface{}{}},
+			err:       `must be written as "10"`,
+		},
+		{
+			parameter: map[string]interface{}{"id": "wide", "type": "Boolean", "default": "TRUE", "options": []interface{}{}},
+			err:       `must be written as "true"`,
+		},
+		{
+			parameter: map[string]interface{}{"id": "mode", "type": "Enum", "default": "", "options": []interface{}{}},
+			err:       "needs options",
+		},
+		{
+			parameter: map[string]interface{}{"id": "mode", "type": "Enum", "default": "dark", "options": []interface{}{"light"}},
+			err:       "not one of its options",
+		},
+		{
+			parameter: map[string]interface{}{"id": "title", "type": "Symbol", "default": "", "options": []interface{}{"a"}},
+			err:       "only supported for type Enum",
+		},
+	}
+
+	for _, c := range cases {
+		err := checkExtensionParameter(c.parameter)
+
+		if c.err == "" && err != nil {
+			t.Errorf("%v: unexpected error %s", c.parameter, err)
+		}
+
+		if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+			t.Errorf("%v: expected error containing %q, got %v", c.parameter, c.err, err)
+		}
+	}
+}
+
+func testAccCheckContentfulUIExtensionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*contentful.Contentful)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_ui_extension" {
+			continue
+		}
+
+		spaceID := rs.Primary.Attributes["space_id"]
+		environment := rs.Primary.Attributes["environment"]
+
+		err := cmaGet(client, uiExtensionPath(spaceID, environment, rs.Primary.ID), nil, &uiExtension{})
+		if err == nil {
+			return fmt.Errorf("UI extension still exists with id: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccContentfulUIExtensionConfig(spaceName, path string) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  default_locale = "en-US"
+  deletion_protection = false
+}
+
+resource "contentful_ui_extension" "hosted" {
+  space_id = "${contentful_space.myspace.id}"
+  extension_id = "picker"
+
+  name = "Picker"
+  src = "https://example.com/picker.html"
+  field_types = ["Symbol", "Entries"]
+
+  installation_parameter {
+    id = "pageSize"
+    name = "Page size"
+    type = "Number"
+    default = "10"
+  }
+
+  installation_parameter {
+    id = "theme"
+    name = "Theme"
+    type = "Enum"
+    options = ["light", "dark"]
+    default = "light"
+  }
+}
+
+resource "contentful_ui_extension" "local" {
+  space_id = "${contentful_space.myspace.id}"
+
+  name = "Preview"
+  srcdoc_path = "%s"
+  sidebar = true
+}
+`, spaceName, path)
+}
@@ -133,6 +133,7 @@ func testAccContentfulWebhookDestroy(s *terraform.State) error {
 var testAccContentfulWebhookConfig = `
 resource "contentful_space" "myspace" {
   name = "space-name"
+  deletion_protection = false
 }
 
 resource "contentful_webhook" "mywebhook" {
@@ -157,6 +158,7 @@ resource "contentful_webhook" "mywebhook" {
 var testAccContentfulWebhookUpdateConfig = `
 resource "contentful_space" "myspace" {
   name = "space-name"
+  deletion_protection = false
 }
 
 resource "contentful_webhook" "mywebhook" {